@@ -0,0 +1,349 @@
+// Package analyzer holds static analyzers for the types generated by go-enum.
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// generatedMarker is the start of the header written at the top of every generated file.
+const generatedMarker = "// Code generated by go-enum"
+
+// Enums finds the go-enum types of a package, and exports them as facts so the analyzers of
+// importing packages know about them too.  A type is an enum when its doc holds an `ENUM(`
+// declaration, or when its constants are declared in a file generated by go-enum.
+var Enums = &analysis.Analyzer{
+	Name:       "goenums",
+	Doc:        "find the enum types generated by go-enum",
+	Run:        runEnums,
+	FactTypes:  []analysis.Fact{new(Enum)},
+	ResultType: reflect.TypeOf(new(EnumTypes)),
+}
+
+// Member is a declared value of an enum.
+type Member struct {
+	Name string
+	// Value is the exact string of the constant value, used to compare members.
+	Value string
+	// Text is the name returned by String(), or empty when it isn't known.
+	Text string
+}
+
+// Enum is the fact attached to the type name of a go-enum type.
+type Enum struct {
+	// Members holds one member per distinct value, in declaration order.
+	Members []Member
+	// Open is set for the enums generated with --open, which accept any value.
+	Open bool
+}
+
+// AFact marks Enum as a fact.
+func (*Enum) AFact() {}
+
+func (e *Enum) String() string {
+	names := make([]string, 0, len(e.Members))
+	for _, m := range e.Members {
+		names = append(names, m.Name)
+	}
+	return "enum(" + strings.Join(names, ", ") + ")"
+}
+
+// EnumTypes is the result of the Enums analyzer, holding the enums of the package and of
+// the packages it imports.
+type EnumTypes struct {
+	enums map[*types.TypeName]*Enum
+}
+
+// Lookup returns the type name and enum of t, or nil if t is not a go-enum type.
+func (e *EnumTypes) Lookup(t types.Type) (*types.TypeName, *Enum) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+	obj := named.Obj()
+	if enum, ok := e.enums[obj]; ok {
+		return obj, enum
+	}
+	return nil, nil
+}
+
+func runEnums(pass *analysis.Pass) (interface{}, error) {
+	result := &EnumTypes{enums: make(map[*types.TypeName]*Enum)}
+	for _, fact := range pass.AllObjectFacts() {
+		if tn, ok := fact.Object.(*types.TypeName); ok {
+			result.enums[tn] = fact.Fact.(*Enum)
+		}
+	}
+
+	annotated := make(map[*types.TypeName]bool)
+	for _, file := range pass.Files {
+		generated := isGoEnumFile(file)
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if hasEnumDecl(s.Doc) || hasEnumDecl(gd.Doc) {
+						if tn, ok := pass.TypesInfo.Defs[s.Name].(*types.TypeName); ok {
+							annotated[tn] = true
+						}
+					}
+				case *ast.ValueSpec:
+					if !generated {
+						continue
+					}
+					for _, name := range s.Names {
+						if c, ok := pass.TypesInfo.Defs[name].(*types.Const); ok {
+							if tn := localTypeName(pass.Pkg, c.Type()); tn != nil {
+								annotated[tn] = true
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// Collect the members from the generated files in declaration order, so the constants are
+	// listed the way they were declared in the ENUM() comment.  A constant of the type declared
+	// by hand is not a declared value.
+	for _, file := range pass.Files {
+		if !isGoEnumFile(file) {
+			continue
+		}
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				vs, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for _, name := range vs.Names {
+					c, ok := pass.TypesInfo.Defs[name].(*types.Const)
+					if !ok || name.Name == "_" {
+						continue
+					}
+					tn := localTypeName(pass.Pkg, c.Type())
+					if tn == nil || !annotated[tn] {
+						continue
+					}
+					enum := result.enums[tn]
+					if enum == nil {
+						enum = &Enum{}
+						result.enums[tn] = enum
+					}
+					enum.add(c)
+				}
+			}
+		}
+	}
+
+	for _, file := range pass.Files {
+		if isGoEnumFile(file) {
+			collectNames(pass, file, result)
+		}
+	}
+
+	for tn, enum := range result.enums {
+		if tn.Pkg() == pass.Pkg {
+			pass.ExportObjectFact(tn, enum)
+		}
+	}
+	return result, nil
+}
+
+// KnownNames reports whether the names returned by String() are known for every member.
+func (e *Enum) KnownNames() bool {
+	for _, m := range e.Members {
+		if m.Text == "" {
+			return false
+		}
+	}
+	return len(e.Members) > 0
+}
+
+// setText records the String() name of the member with the given value.
+func (e *Enum) setText(value constant.Value, text string) {
+	for i := range e.Members {
+		if e.Members[i].Value == value.ExactString() {
+			e.Members[i].Text = text
+		}
+	}
+}
+
+// add appends c to the members, unless its value is already declared.
+func (e *Enum) add(c *types.Const) {
+	if c.Val().Kind() == constant.Unknown {
+		return
+	}
+	value := c.Val().ExactString()
+	for _, m := range e.Members {
+		if m.Value == value {
+			return
+		}
+	}
+	m := Member{Name: c.Name(), Value: value}
+	if c.Val().Kind() == constant.String {
+		// String() of a string enum returns the value itself.
+		m.Text = constant.StringVal(c.Val())
+	}
+	e.Members = append(e.Members, m)
+}
+
+// collectNames reads the String() names of the integer enums from a generated file, either
+// from the map of names, or from the switch in String().  It also marks the open enums.  The
+// names of enums generated with a dense index are not read, so those names stay unknown.
+func collectNames(pass *analysis.Pass, file *ast.File, result *EnumTypes) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.CompositeLit:
+			t := pass.TypesInfo.TypeOf(x)
+			if t == nil {
+				return true
+			}
+			m, ok := t.Underlying().(*types.Map)
+			if !ok || !isString(m.Elem()) {
+				return true
+			}
+			enum := result.enums[localTypeName(pass.Pkg, m.Key())]
+			if enum == nil {
+				return true
+			}
+			for _, elt := range x.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key := pass.TypesInfo.Types[kv.Key].Value
+				if text, ok := stringValue(pass, kv.Value); ok && key != nil {
+					enum.setText(key, text)
+				}
+			}
+		case *ast.FuncDecl:
+			if x.Recv == nil || len(x.Recv.List) != 1 || x.Body == nil {
+				return false
+			}
+			enum := result.enums[localTypeName(pass.Pkg, pass.TypesInfo.TypeOf(x.Recv.List[0].Type))]
+			if enum == nil {
+				return false
+			}
+			if x.Name.Name == "IsKnown" {
+				enum.Open = true
+			}
+			if x.Name.Name != "String" {
+				return false
+			}
+			for _, stmt := range x.Body.List {
+				sw, ok := stmt.(*ast.SwitchStmt)
+				if !ok {
+					continue
+				}
+				for _, s := range sw.Body.List {
+					clause := s.(*ast.CaseClause)
+					if len(clause.List) != 1 || len(clause.Body) != 1 {
+						continue
+					}
+					ret, ok := clause.Body[0].(*ast.ReturnStmt)
+					if !ok || len(ret.Results) != 1 {
+						continue
+					}
+					key := pass.TypesInfo.Types[clause.List[0]].Value
+					if text, ok := stringValue(pass, ret.Results[0]); ok && key != nil {
+						enum.setText(key, text)
+					}
+				}
+			}
+			return false
+		}
+		return true
+	})
+}
+
+// stringValue evaluates a constant string, or a slice of a constant string with constant
+// bounds, such as the _ColorName[0:3] written by the generator.
+func stringValue(pass *analysis.Pass, expr ast.Expr) (string, bool) {
+	if v := pass.TypesInfo.Types[expr].Value; v != nil {
+		if v.Kind() != constant.String {
+			return "", false
+		}
+		return constant.StringVal(v), true
+	}
+	slice, ok := expr.(*ast.SliceExpr)
+	if !ok || slice.Low == nil || slice.High == nil || slice.Slice3 {
+		return "", false
+	}
+	s, ok := stringValue(pass, slice.X)
+	if !ok {
+		return "", false
+	}
+	low, ok := intValue(pass, slice.Low)
+	if !ok {
+		return "", false
+	}
+	high, ok := intValue(pass, slice.High)
+	if !ok || low < 0 || low > high || high > int64(len(s)) {
+		return "", false
+	}
+	return s[low:high], true
+}
+
+func intValue(pass *analysis.Pass, expr ast.Expr) (int64, bool) {
+	v := pass.TypesInfo.Types[expr].Value
+	if v == nil {
+		return 0, false
+	}
+	return constant.Int64Val(constant.ToInt(v))
+}
+
+func isString(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsString != 0
+}
+
+// localTypeName returns the type name of t when it is a named type declared in pkg.
+func localTypeName(pkg *types.Package, t types.Type) *types.TypeName {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() != pkg {
+		return nil
+	}
+	return named.Obj()
+}
+
+// hasEnumDecl reports whether the comments hold an `ENUM(` declaration.
+func hasEnumDecl(doc *ast.CommentGroup) bool {
+	if doc == nil {
+		return false
+	}
+	for _, comment := range doc.List {
+		if strings.Contains(comment.Text, `ENUM(`) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGoEnumFile reports whether the file was generated by go-enum.
+func isGoEnumFile(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			return false
+		}
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, generatedMarker) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// NonExhaustiveDirective opts a switch out of the exhaustive check when it is written in
+// the default clause of the switch, or on the line above it.
+const NonExhaustiveDirective = "//go-enum:nonexhaustive"
+
+// Exhaustive reports switch statements on a go-enum type that don't list every value.
+var Exhaustive = &analysis.Analyzer{
+	Name: "exhaustive",
+	Doc: `check that switch statements on go-enum types handle every value
+
+A switch on a go-enum type must have a case for every declared value, even
+when it has a default clause, so adding a value to the ENUM() declaration
+flags every switch that needs to handle it.  Write the comment
+
+	` + NonExhaustiveDirective + `
+
+in the default clause, or on the line above the switch, to opt out.`,
+	Run:      runExhaustive,
+	Requires: []*analysis.Analyzer{Enums},
+}
+
+func runExhaustive(pass *analysis.Pass) (interface{}, error) {
+	enums := pass.ResultOf[Enums].(*EnumTypes)
+	for _, file := range pass.Files {
+		if isGoEnumFile(file) {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if stmt, ok := n.(*ast.SwitchStmt); ok && stmt.Tag != nil {
+				checkSwitch(pass, enums, file, stmt)
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+func checkSwitch(pass *analysis.Pass, enums *EnumTypes, file *ast.File, stmt *ast.SwitchStmt) {
+	tn, enum := enums.Lookup(pass.TypesInfo.TypeOf(stmt.Tag))
+	if enum == nil {
+		return
+	}
+
+	covered := make(map[string]bool)
+	var defaultClause *ast.CaseClause
+	defaultEnd := stmt.Body.Rbrace
+	for i, s := range stmt.Body.List {
+		clause := s.(*ast.CaseClause)
+		if clause.List == nil {
+			defaultClause = clause
+			if i+1 < len(stmt.Body.List) {
+				defaultEnd = stmt.Body.List[i+1].Pos()
+			}
+			continue
+		}
+		for _, expr := range clause.List {
+			if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+				covered[tv.Value.ExactString()] = true
+			}
+		}
+	}
+
+	var missing []string
+	for _, m := range enum.Members {
+		if !covered[m.Value] {
+			missing = append(missing, m.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	switchLine := pass.Fset.Position(stmt.Pos()).Line
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			if !strings.HasPrefix(comment.Text, NonExhaustiveDirective) {
+				continue
+			}
+			if defaultClause != nil && comment.Pos() > defaultClause.Pos() && comment.Pos() < defaultEnd {
+				return
+			}
+			if line := pass.Fset.Position(comment.Pos()).Line; line == switchLine-1 || line == switchLine {
+				return
+			}
+		}
+	}
+
+	diag := analysis.Diagnostic{
+		Pos:     stmt.Pos(),
+		End:     stmt.Body.Lbrace,
+		Message: fmt.Sprintf("missing cases in switch of type %s: %s", typeName(pass, tn), strings.Join(missing, ", ")),
+	}
+	if prefix, ok := importPrefix(pass, file, tn.Pkg()); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{missingCasesFix(pass, stmt, defaultClause, prefix, missing)}
+	}
+	pass.Report(diag)
+}
+
+// missingCasesFix adds a case clause listing the missing values, before the default clause
+// or at the end of the switch.
+func missingCasesFix(pass *analysis.Pass, stmt *ast.SwitchStmt, defaultClause *ast.CaseClause, prefix string, missing []string) analysis.SuggestedFix {
+	names := make([]string, 0, len(missing))
+	for _, name := range missing {
+		names = append(names, prefix+name)
+	}
+	pos := stmt.Body.Rbrace
+	if defaultClause != nil {
+		pos = defaultClause.Pos()
+	}
+	// gofmt indents the case clauses at the same depth as the switch.
+	indent := strings.Repeat("\t", pass.Fset.Position(stmt.Pos()).Column-1)
+	return analysis.SuggestedFix{
+		Message: "Add the missing cases",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     pos,
+			End:     pos,
+			NewText: []byte("case " + strings.Join(names, ", ") + ":\n" + indent),
+		}},
+	}
+}
+
+// importPrefix returns the prefix used in file to refer to the names of pkg, and false when
+// pkg is not imported by file.
+func importPrefix(pass *analysis.Pass, file *ast.File, pkg *types.Package) (string, bool) {
+	if pkg == pass.Pkg {
+		return "", true
+	}
+	for _, spec := range file.Imports {
+		pkgName := pass.TypesInfo.PkgNameOf(spec)
+		if pkgName == nil || pkgName.Imported() != pkg {
+			continue
+		}
+		switch pkgName.Name() {
+		case ".":
+			return "", true
+		case "_":
+			continue
+		}
+		return pkgName.Name() + ".", true
+	}
+	return "", false
+}
@@ -0,0 +1,11 @@
+package analyzer
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestExhaustive(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Exhaustive, "a", "b")
+}
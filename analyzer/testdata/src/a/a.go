@@ -0,0 +1,80 @@
+package a
+
+// Color is an enumeration of colors.
+// ENUM(red, green, blue)
+type Color int
+
+// ColorLegacy is declared by hand, so it isn't a value a switch has to handle.
+const ColorLegacy Color = 17
+
+// Plain is not an enumeration.
+type Plain int
+
+const (
+	PlainOne Plain = iota
+	PlainTwo
+)
+
+func complete(c Color) {
+	switch c {
+	case ColorRed, ColorGreen:
+	case ColorBlue:
+	}
+}
+
+func missing(c Color) {
+	switch c { // want `missing cases in switch of type Color: ColorGreen, ColorBlue`
+	case ColorRed:
+	}
+}
+
+func missingWithDefault(c Color) string {
+	for {
+		switch c { // want `missing cases in switch of type Color: ColorBlue`
+		case ColorRed, ColorGreen:
+			return "warm"
+		default:
+			return "unknown"
+		}
+	}
+}
+
+func optOutInDefault(c Color) {
+	switch c {
+	case ColorRed:
+	default: //go-enum:nonexhaustive
+	}
+}
+
+func optOutInDefaultBody(c Color) {
+	switch c {
+	default:
+		//go-enum:nonexhaustive
+	case ColorRed:
+	}
+}
+
+func optOutAbove(c Color) {
+	//go-enum:nonexhaustive
+	switch c {
+	case ColorRed:
+	}
+}
+
+func generatedOnly(s Shape) {
+	switch s { // want `missing cases in switch of type Shape: ShapeSquare`
+	case ShapeCircle:
+	}
+}
+
+func notEnum(p Plain) {
+	switch p {
+	case PlainOne:
+	}
+}
+
+func tagless(c Color) {
+	switch {
+	case c == ColorRed:
+	}
+}
@@ -0,0 +1,17 @@
+package b
+
+import (
+	colors "a"
+)
+
+func missing(c colors.Color) {
+	switch c { // want `missing cases in switch of type a.Color: ColorRed`
+	case colors.ColorGreen, colors.ColorBlue:
+	}
+}
+
+func complete(c colors.Color) {
+	switch c {
+	case colors.ColorRed, colors.ColorGreen, colors.ColorBlue:
+	}
+}
@@ -0,0 +1,72 @@
+package c
+
+// Status is an integer enumeration with a map of names.
+// ENUM(ok=200, not_found=404)
+type Status int
+
+// Color is a string enumeration.
+// ENUM(red, green)
+type Color string
+
+// Level is an integer enumeration whose String() is a switch.
+// ENUM(low, high)
+type Level int
+
+// Dense is an integer enumeration whose names are not known.
+// ENUM(a, b)
+type Dense int
+
+const favourite Color = "green"
+
+// legacy and bogus are declared by hand, which doesn't make them declared values.
+const (
+	legacy Status = 17     // want `17 is not a valid Status`
+	bogus  Color  = "reed" // want `"reed" is not a valid Color`
+)
+
+var (
+	okStatus         = Status(200)
+	badStatus        = Status(17)    // want `17 is not a valid Status`
+	badColor         = Color("reed") // want `"reed" is not a valid Color`
+	goodColor        = Color(favourite)
+	shifted          = StatusOK + 1 // want `201 is not a valid Status`
+	literal   Status = 404
+	untyped   Status = 500 // want `500 is not a valid Status`
+)
+
+func takes(s Status) {}
+
+func returns() Color {
+	return "blue" // want `"blue" is not a valid Color`
+}
+
+func uses(s Status, c Color, l Level, d Dense) bool {
+	takes(200)
+	takes(3)      // want `3 is not a valid Status`
+	if s == 418 { // want `418 is not a valid Status`
+		return true
+	}
+	switch c {
+	case ColorRed, "purple": // want `"purple" is not a valid Color`
+	}
+	if s.String() == "not_found" || s.String() != "teapot" { // want `"teapot" is not a valid Status name`
+		return true
+	}
+	if "red" == c.String() || c.String() == "reed" { // want `"reed" is not a valid Color name`
+		return true
+	}
+	switch l.String() {
+	case "low", "medium": // want `"medium" is not a valid Level name`
+	}
+	return d.String() == "c"
+}
+
+// Open is an open enumeration, any value is allowed.
+// ENUM(draft, sent)
+type Open string
+
+var custom = Open("archived")
+
+func open(o Open) bool {
+	return o.String() == "archived"
+}
@@ -0,0 +1,12 @@
+package d
+
+import "c"
+
+var (
+	status = c.Status(404)
+	bad    = c.Status(1) // want `1 is not a valid c.Status`
+)
+
+func name(s c.Status) bool {
+	return s.String() == "notfound" // want `"notfound" is not a valid c.Status name`
+}
@@ -0,0 +1,106 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Values reports constants of a go-enum type that are not declared values, and names
+// compared against String() that are not declared names.
+var Values = &analysis.Analyzer{
+	Name: "enumvalues",
+	Doc: `check that constants of go-enum types are declared values
+
+A conversion such as Color("reed") or Status(17), or an untyped constant
+assigned, passed or compared to a go-enum type, compiles even when the value
+isn't declared.  This reports those constants, and the string constants that
+the result of String() is compared or switched against that aren't declared
+names.  The names of integer enums generated with a dense index are not known,
+so their String() comparisons are not checked.`,
+	Run:      runValues,
+	Requires: []*analysis.Analyzer{Enums},
+}
+
+func runValues(pass *analysis.Pass) (interface{}, error) {
+	enums := pass.ResultOf[Enums].(*EnumTypes)
+	for _, file := range pass.Files {
+		if isGoEnumFile(file) {
+			continue
+		}
+		// Only the constants of the generated files are declared values, so the ones declared
+		// here are checked like any other constant.
+		ast.Inspect(file, func(n ast.Node) bool {
+			if expr, ok := n.(ast.Expr); ok {
+				if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil {
+					if tn, enum := enums.Lookup(tv.Type); enum != nil && !enum.Open && !enum.declares(tv.Value) {
+						pass.Reportf(expr.Pos(), "%s is not a valid %s", tv.Value.ExactString(), typeName(pass, tn))
+					}
+					return false
+				}
+			}
+			switch x := n.(type) {
+			case *ast.BinaryExpr:
+				if x.Op == token.EQL || x.Op == token.NEQ {
+					checkName(pass, enums, x.X, x.Y)
+					checkName(pass, enums, x.Y, x.X)
+				}
+			case *ast.SwitchStmt:
+				if x.Tag != nil {
+					for _, s := range x.Body.List {
+						for _, expr := range s.(*ast.CaseClause).List {
+							checkName(pass, enums, x.Tag, expr)
+						}
+					}
+				}
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// checkName reports name when call is a call of String() on a go-enum type, and name is a
+// constant string that isn't one of its names.
+func checkName(pass *analysis.Pass, enums *EnumTypes, call, name ast.Expr) {
+	c, ok := ast.Unparen(call).(*ast.CallExpr)
+	if !ok || len(c.Args) != 0 {
+		return
+	}
+	sel, ok := c.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "String" {
+		return
+	}
+	tn, enum := enums.Lookup(pass.TypesInfo.TypeOf(sel.X))
+	if enum == nil || enum.Open || !enum.KnownNames() {
+		return
+	}
+	v := pass.TypesInfo.Types[name].Value
+	if v == nil || v.Kind() != constant.String {
+		return
+	}
+	text := constant.StringVal(v)
+	for _, m := range enum.Members {
+		if m.Text == text {
+			return
+		}
+	}
+	pass.Reportf(name.Pos(), "%s is not a valid %s name", v.ExactString(), typeName(pass, tn))
+}
+
+// declares reports whether v is the value of one of the members.
+func (e *Enum) declares(v constant.Value) bool {
+	for _, m := range e.Members {
+		if m.Value == v.ExactString() {
+			return true
+		}
+	}
+	return false
+}
+
+func typeName(pass *analysis.Pass, tn *types.TypeName) string {
+	return types.TypeString(tn.Type(), types.RelativeTo(pass.Pkg))
+}
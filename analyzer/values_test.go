@@ -0,0 +1,11 @@
+package analyzer
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestValues(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Values, "c", "d")
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// buildCache remembers the files generated for each input by its cache key, so the inputs that
+// didn't change since their files were generated are skipped.
+type buildCache struct {
+	dir string
+}
+
+// cacheEntry is stored for each key, with the hash of each generated file by path.
+type cacheEntry struct {
+	Outputs map[string]string `json:"outputs"`
+}
+
+// newBuildCache returns the cache stored in dir, which is the go-enum directory of the user cache
+// directory when empty.
+func newBuildCache(dir string) (*buildCache, error) {
+	if dir == "" {
+		userDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed finding the cache directory: %w", err)
+		}
+		dir = filepath.Join(userDir, "go-enum")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed creating the cache directory %s: %w", dir, err)
+	}
+	return &buildCache{dir: dir}, nil
+}
+
+// key returns the key of the parts, which are the cache key of the input and the options of the
+// command that change what is written for it.
+func (c *buildCache) key(parts ...string) string {
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// fresh reports whether files were generated for the key, and are all unchanged.
+func (c *buildCache) fresh(key string) bool {
+	raw, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return false
+	}
+	for path, hash := range entry.Outputs {
+		raw, err := os.ReadFile(path)
+		if err != nil || contentHash(raw) != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// store records the files generated for the key.  The entry is renamed into place, so concurrent
+// runs never read half of it.
+func (c *buildCache) store(key string, outputs map[string][]byte) error {
+	entry := cacheEntry{Outputs: make(map[string]string, len(outputs))}
+	for path, raw := range outputs {
+		entry.Outputs[path] = contentHash(raw)
+	}
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(c.dir, key+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed writing to the cache: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(raw); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed writing to the cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed writing to the cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("failed writing to the cache: %w", err)
+	}
+	return nil
+}
+
+func (c *buildCache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// contentHash returns the hash of the contents of a file.
+func contentHash(raw []byte) string {
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// outputDiff returns a unified diff from the file at path to the generated code, or an empty string
+// when the file is up to date.  A missing file is diffed as an empty one.
+func outputDiff(path string, raw []byte) (string, error) {
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed reading file %s: %w", path, err)
+	}
+	if bytes.Equal(existing, raw) {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(existing),
+		B:        splitLines(raw),
+		FromFile: path,
+		ToFile:   path + " (generated)",
+		Context:  3,
+	})
+}
+
+// splitLines splits the file into lines, keeping the line endings.  Unlike difflib.SplitLines, an empty
+// file has no lines.
+func splitLines(raw []byte) []string {
+	lines := strings.SplitAfter(string(raw), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
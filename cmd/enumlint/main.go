@@ -0,0 +1,20 @@
+// enumlint checks the code using the enum types generated by go-enum.
+//
+// It can be run on its own
+//
+//	enumlint ./...
+//
+// or by go vet
+//
+//	go vet -vettool=$(which enumlint) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/abice/go-enum/analyzer"
+)
+
+func main() {
+	multichecker.Main(analyzer.Exhaustive, analyzer.Values)
+}
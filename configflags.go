@@ -0,0 +1,108 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/abice/go-enum/generator"
+)
+
+// configFlags maps the GeneratorConfig fields to the flags that set them, so the configuration errors
+// name what the user typed.
+var configFlags = map[string]string{
+	"NoPrefix":          "noprefix",
+	"NoIota":            "no-iota",
+	"LowercaseLookup":   "lower",
+	"CaseInsensitive":   "nocase",
+	"Marshal":           "marshal",
+	"SQL":               "sql",
+	"SQLInt":            "sqlint",
+	"Flag":              "flag",
+	"Names":             "names",
+	"Values":            "values",
+	"LeaveSnakeCase":    "nocamel",
+	"JSONPkg":           "jsonpkg",
+	"Prefix":            "prefix",
+	"SQLNullInt":        "sqlnullint",
+	"SQLNullStr":        "sqlnullstr",
+	"Ptr":               "ptr",
+	"MustParse":         "mustparse",
+	"ForceLower":        "forcelower",
+	"ForceUpper":        "forceupper",
+	"NoComments":        "nocomments",
+	"NoParse":           "noparse",
+	"OpenEnum":          "open",
+	"DefaultFallback":   "default-fallback",
+	"FastLookup":        "fast",
+	"TypedErrors":       "typed-errors",
+	"Suggest":           "suggest",
+	"Normalize":         "normalize",
+	"Normalizer":        "normalizer",
+	"Ordinal":           "ordinal",
+	"OrdinalWrap":       "ordinal-wrap",
+	"Iterators":         "iter",
+	"GoVersion":         "go-version",
+	"Set":               "set",
+	"EnumMap":           "enum-map",
+	"Match":             "match",
+	"Display":           "display",
+	"Validator":         "validator",
+	"ValidateMethod":    "validate",
+	"Guard":             "guard",
+	"BuildTags":         "buildtag",
+	"ReplacementNames":  "alias",
+	"TemplateFileNames": "template",
+}
+
+// configFieldPattern matches the GeneratorConfig fields named in the reason of a configuration error.
+var configFieldPattern = func() *regexp.Regexp {
+	fields := make([]string, 0, len(configFlags))
+	for field := range configFlags {
+		fields = append(fields, regexp.QuoteMeta(field))
+	}
+	return regexp.MustCompile(`\b(` + strings.Join(fields, "|") + `)\b`)
+}()
+
+// flagMessage returns the message of a configuration error with the flags in place of the
+// GeneratorConfig fields.
+func flagMessage(err *generator.ConfigError) string {
+	flags := make([]string, 0, len(err.Options))
+	for _, option := range err.Options {
+		flags = append(flags, flagName(option))
+	}
+	reason := configFieldPattern.ReplaceAllStringFunc(err.Reason, flagName)
+	if err.Enum != "" {
+		return fmt.Sprintf("invalid flags %s for enum %s: %s", strings.Join(flags, ", "), err.Enum, reason)
+	}
+	return fmt.Sprintf("invalid flags %s: %s", strings.Join(flags, ", "), reason)
+}
+
+// flagName returns the flag setting a GeneratorConfig field, or the field when no flag sets it.
+func flagName(field string) string {
+	if flag, ok := configFlags[field]; ok {
+		return "--" + flag
+	}
+	return field
+}
+
+// flagErrors returns err with the configuration errors joined in it written in terms of the flags.
+func flagErrors(err error) error {
+	var errs []error
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	} else {
+		errs = []error{err}
+	}
+
+	converted := make([]error, 0, len(errs))
+	for _, e := range errs {
+		var configErr *generator.ConfigError
+		if errors.As(e, &configErr) {
+			e = errors.New(flagMessage(configErr))
+		}
+		converted = append(converted, e)
+	}
+	return errors.Join(converted...)
+}
@@ -69,3 +69,12 @@ func ParseAnimal(name string) (Animal, error) {
 	}
 	return Animal(0), fmt.Errorf("%s is %w", name, ErrInvalidAnimal)
 }
+
+// ParseAnimalBytes attempts to convert a byte slice to a Animal, without allocating
+// when name is valid.
+func ParseAnimalBytes(name []byte) (Animal, error) {
+	if x, ok := _AnimalValue[string(name)]; ok {
+		return x, nil
+	}
+	return Animal(0), fmt.Errorf("%s is %w", name, ErrInvalidAnimal)
+}
@@ -61,3 +61,12 @@ func ParseBuggy(name string) (Buggy, error) {
 	}
 	return Buggy(0), fmt.Errorf("%s is %w", name, ErrInvalidBuggy)
 }
+
+// ParseBuggyBytes attempts to convert a byte slice to a Buggy, without allocating
+// when name is valid.
+func ParseBuggyBytes(name []byte) (Buggy, error) {
+	if x, ok := _BuggyValue[string(name)]; ok {
+		return x, nil
+	}
+	return Buggy(0), fmt.Errorf("%s is %w", name, ErrInvalidBuggy)
+}
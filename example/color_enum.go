@@ -107,6 +107,15 @@ func ParseColor(name string) (Color, error) {
 	return Color(0), fmt.Errorf("%s is %w", name, ErrInvalidColor)
 }
 
+// ParseColorBytes attempts to convert a byte slice to a Color, without allocating
+// when name is valid.
+func ParseColorBytes(name []byte) (Color, error) {
+	if x, ok := _ColorValue[string(name)]; ok {
+		return x, nil
+	}
+	return Color(0), fmt.Errorf("%s is %w", name, ErrInvalidColor)
+}
+
 // MustParseColor converts a string to a Color, and panics if is not valid.
 func MustParseColor(name string) Color {
 	val, err := ParseColor(name)
@@ -127,8 +136,7 @@ func (x Color) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *Color) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseColor(name)
+	tmp, err := ParseColorBytes(text)
 	if err != nil {
 		return err
 	}
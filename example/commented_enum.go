@@ -68,6 +68,15 @@ func ParseCommented(name string) (Commented, error) {
 	return Commented(0), fmt.Errorf("%s is %w", name, ErrInvalidCommented)
 }
 
+// ParseCommentedBytes attempts to convert a byte slice to a Commented, without allocating
+// when name is valid.
+func ParseCommentedBytes(name []byte) (Commented, error) {
+	if x, ok := _CommentedValue[string(name)]; ok {
+		return x, nil
+	}
+	return Commented(0), fmt.Errorf("%s is %w", name, ErrInvalidCommented)
+}
+
 // MarshalText implements the text marshaller method.
 func (x Commented) MarshalText() ([]byte, error) {
 	return []byte(x.String()), nil
@@ -75,8 +84,7 @@ func (x Commented) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *Commented) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseCommented(name)
+	tmp, err := ParseCommentedBytes(text)
 	if err != nil {
 		return err
 	}
@@ -148,6 +156,15 @@ func ParseComplexCommented(name string) (ComplexCommented, error) {
 	return ComplexCommented(0), fmt.Errorf("%s is %w", name, ErrInvalidComplexCommented)
 }
 
+// ParseComplexCommentedBytes attempts to convert a byte slice to a ComplexCommented, without allocating
+// when name is valid.
+func ParseComplexCommentedBytes(name []byte) (ComplexCommented, error) {
+	if x, ok := _ComplexCommentedValue[string(name)]; ok {
+		return x, nil
+	}
+	return ComplexCommented(0), fmt.Errorf("%s is %w", name, ErrInvalidComplexCommented)
+}
+
 // MarshalText implements the text marshaller method.
 func (x ComplexCommented) MarshalText() ([]byte, error) {
 	return []byte(x.String()), nil
@@ -155,8 +172,7 @@ func (x ComplexCommented) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *ComplexCommented) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseComplexCommented(name)
+	tmp, err := ParseComplexCommentedBytes(text)
 	if err != nil {
 		return err
 	}
@@ -61,3 +61,12 @@ func ParseProduct(name string) (Product, error) {
 	}
 	return Product(0), fmt.Errorf("%s is %w", name, ErrInvalidProduct)
 }
+
+// ParseProductBytes attempts to convert a byte slice to a Product, without allocating
+// when name is valid.
+func ParseProductBytes(name []byte) (Product, error) {
+	if x, ok := _ProductValue[string(name)]; ok {
+		return x, nil
+	}
+	return Product(0), fmt.Errorf("%s is %w", name, ErrInvalidProduct)
+}
@@ -0,0 +1,17 @@
+//go:generate ../bin/go-enum --marshal --sql --flag --default-fallback -b example
+
+package example
+
+// Priority is an enumeration with a designated default value.
+// ENUM(unknown [default], low, medium, high)
+type Priority int
+
+// Channel is a string enumeration with a designated default value.
+/*
+ENUM(
+	email
+	sms
+	push [default] // Push is used when no channel is requested.
+)
+*/
+type Channel string
@@ -0,0 +1,379 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+const (
+	// ChannelEmail is a Channel of type email.
+	ChannelEmail Channel = "email"
+	// ChannelSms is a Channel of type sms.
+	ChannelSms Channel = "sms"
+	// ChannelPush is a Channel of type push.
+	// Push is used when no channel is requested.
+	ChannelPush Channel = "push"
+)
+
+var ErrInvalidChannel = errors.New("not a valid Channel")
+
+// String implements the Stringer interface.
+func (x Channel) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Channel) IsValid() bool {
+	_, err := ParseChannel(string(x))
+	return err == nil
+}
+
+var _ChannelValue = map[string]Channel{
+	"email": ChannelEmail,
+	"sms":   ChannelSms,
+	"push":  ChannelPush,
+}
+
+// ParseChannel attempts to convert a string to a Channel.
+func ParseChannel(name string) (Channel, error) {
+	if x, ok := _ChannelValue[name]; ok {
+		return x, nil
+	}
+	return Channel(""), fmt.Errorf("%s is %w", name, ErrInvalidChannel)
+}
+
+// ParseChannelBytes attempts to convert a byte slice to a Channel, without allocating
+// when name is valid.
+func ParseChannelBytes(name []byte) (Channel, error) {
+	if x, ok := _ChannelValue[string(name)]; ok {
+		return x, nil
+	}
+	return Channel(""), fmt.Errorf("%s is %w", name, ErrInvalidChannel)
+}
+
+// ChannelDefault returns the default Channel value.
+func ChannelDefault() Channel {
+	return ChannelPush
+}
+
+// ParseChannelOrDefault attempts to convert a string to a Channel, returning
+// ChannelDefault() when it is not valid.
+func ParseChannelOrDefault(name string) Channel {
+	if x, err := ParseChannel(name); err == nil {
+		return x
+	}
+	return ChannelPush
+}
+
+// ChannelFallbackHook, when set, is called whenever an empty or invalid
+// value is replaced with ChannelDefault() while decoding.
+var ChannelFallbackHook func(input string, err error)
+
+// parseFallbackChannel attempts to convert a string to a Channel, falling
+// back to ChannelDefault() when it is empty or not valid.
+func parseFallbackChannel(name string) (Channel, error) {
+	x, err := ParseChannel(name)
+	if err != nil {
+		if ChannelFallbackHook != nil {
+			ChannelFallbackHook(name, err)
+		}
+		return ChannelPush, nil
+	}
+	return x, nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x Channel) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Channel) UnmarshalText(text []byte) error {
+	tmp, err := parseFallbackChannel(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Channel) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errChannelNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Channel) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ChannelPush
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = parseFallbackChannel(v)
+	case []byte:
+		*x, err = parseFallbackChannel(string(v))
+	case Channel:
+		*x = v
+	case *Channel:
+		if v == nil {
+			return errChannelNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errChannelNilPtr
+		}
+		*x, err = parseFallbackChannel(*v)
+	default:
+		return errors.New("invalid type for Channel")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Channel) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *Channel) Set(val string) error {
+	v, err := parseFallbackChannel(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Channel) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Channel) Type() string {
+	return "Channel"
+}
+
+const (
+	// PriorityUnknown is a Priority of type Unknown.
+	PriorityUnknown Priority = iota
+	// PriorityLow is a Priority of type Low.
+	PriorityLow
+	// PriorityMedium is a Priority of type Medium.
+	PriorityMedium
+	// PriorityHigh is a Priority of type High.
+	PriorityHigh
+)
+
+var ErrInvalidPriority = errors.New("not a valid Priority")
+
+const _PriorityName = "unknownlowmediumhigh"
+
+var _PriorityMap = map[Priority]string{
+	PriorityUnknown: _PriorityName[0:7],
+	PriorityLow:     _PriorityName[7:10],
+	PriorityMedium:  _PriorityName[10:16],
+	PriorityHigh:    _PriorityName[16:20],
+}
+
+// String implements the Stringer interface.
+func (x Priority) String() string {
+	if str, ok := _PriorityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Priority(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Priority) IsValid() bool {
+	_, ok := _PriorityMap[x]
+	return ok
+}
+
+var _PriorityValue = map[string]Priority{
+	_PriorityName[0:7]:   PriorityUnknown,
+	_PriorityName[7:10]:  PriorityLow,
+	_PriorityName[10:16]: PriorityMedium,
+	_PriorityName[16:20]: PriorityHigh,
+}
+
+// ParsePriority attempts to convert a string to a Priority.
+func ParsePriority(name string) (Priority, error) {
+	if x, ok := _PriorityValue[name]; ok {
+		return x, nil
+	}
+	return Priority(0), fmt.Errorf("%s is %w", name, ErrInvalidPriority)
+}
+
+// ParsePriorityBytes attempts to convert a byte slice to a Priority, without allocating
+// when name is valid.
+func ParsePriorityBytes(name []byte) (Priority, error) {
+	if x, ok := _PriorityValue[string(name)]; ok {
+		return x, nil
+	}
+	return Priority(0), fmt.Errorf("%s is %w", name, ErrInvalidPriority)
+}
+
+// PriorityDefault returns the default Priority value.
+func PriorityDefault() Priority {
+	return PriorityUnknown
+}
+
+// ParsePriorityOrDefault attempts to convert a string to a Priority, returning
+// PriorityDefault() when it is not valid.
+func ParsePriorityOrDefault(name string) Priority {
+	if x, err := ParsePriority(name); err == nil {
+		return x
+	}
+	return PriorityUnknown
+}
+
+// PriorityFallbackHook, when set, is called whenever an empty or invalid
+// value is replaced with PriorityDefault() while decoding.
+var PriorityFallbackHook func(input string, err error)
+
+// parseFallbackPriority attempts to convert a string to a Priority, falling
+// back to PriorityDefault() when it is empty or not valid.
+func parseFallbackPriority(name string) (Priority, error) {
+	x, err := ParsePriority(name)
+	if err != nil {
+		if PriorityFallbackHook != nil {
+			PriorityFallbackHook(name, err)
+		}
+		return PriorityUnknown, nil
+	}
+	return x, nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x Priority) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Priority) UnmarshalText(text []byte) error {
+	tmp, err := parseFallbackPriority(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Priority) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errPriorityNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Priority) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = PriorityUnknown
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = Priority(v)
+	case string:
+		*x, err = parseFallbackPriority(v)
+	case []byte:
+		*x, err = parseFallbackPriority(string(v))
+	case Priority:
+		*x = v
+	case int:
+		*x = Priority(v)
+	case *Priority:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = Priority(v)
+	case uint64:
+		*x = Priority(v)
+	case *int:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = Priority(*v)
+	case *int64:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = Priority(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = Priority(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = Priority(*v)
+	case *uint:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = Priority(*v)
+	case *uint64:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x = Priority(*v)
+	case *string:
+		if v == nil {
+			return errPriorityNilPtr
+		}
+		*x, err = parseFallbackPriority(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Priority) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *Priority) Set(val string) error {
+	v, err := parseFallbackPriority(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Priority) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Priority) Type() string {
+	return "Priority"
+}
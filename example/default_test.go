@@ -0,0 +1,70 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityDefault(t *testing.T) {
+	assert.Equal(t, PriorityUnknown, PriorityDefault())
+	assert.Equal(t, PriorityHigh, ParsePriorityOrDefault("high"))
+	assert.Equal(t, PriorityUnknown, ParsePriorityOrDefault("urgent"))
+
+	_, err := ParsePriority("urgent")
+	assert.ErrorIs(t, err, ErrInvalidPriority)
+}
+
+func TestPriorityFallback(t *testing.T) {
+	type testData struct {
+		Priority Priority `json:"priority"`
+	}
+
+	var warnings []string
+	PriorityFallbackHook = func(input string, err error) {
+		assert.ErrorIs(t, err, ErrInvalidPriority)
+		warnings = append(warnings, input)
+	}
+	defer func() { PriorityFallbackHook = nil }()
+
+	x := testData{Priority: PriorityHigh}
+	require.NoError(t, json.Unmarshal([]byte(`{"priority":"urgent"}`), &x))
+	assert.Equal(t, PriorityUnknown, x.Priority)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"priority":"medium"}`), &x))
+	assert.Equal(t, PriorityMedium, x.Priority)
+
+	var y Priority = PriorityLow
+	require.NoError(t, y.Scan(""))
+	assert.Equal(t, PriorityUnknown, y)
+
+	y = PriorityLow
+	require.NoError(t, y.Scan(nil))
+	assert.Equal(t, PriorityUnknown, y)
+
+	assert.Equal(t, []string{"urgent", ""}, warnings)
+}
+
+func TestChannelFallback(t *testing.T) {
+	assert.Equal(t, ChannelPush, ChannelDefault())
+	assert.Equal(t, ChannelSms, ParseChannelOrDefault("sms"))
+	assert.Equal(t, ChannelPush, ParseChannelOrDefault(""))
+
+	var c Channel
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&c, "channel", "notification channel")
+	require.NoError(t, fs.Parse([]string{"--channel", "pigeon"}))
+	assert.Equal(t, ChannelPush, c)
+
+	require.NoError(t, fs.Parse([]string{"--channel", "email"}))
+	assert.Equal(t, ChannelEmail, c)
+
+	require.NoError(t, c.Scan([]byte("fax")))
+	assert.Equal(t, ChannelPush, c)
+}
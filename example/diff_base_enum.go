@@ -85,3 +85,12 @@ func ParseDiffBase(name string) (DiffBase, error) {
 	}
 	return DiffBase(0), fmt.Errorf("%s is %w", name, ErrInvalidDiffBase)
 }
+
+// ParseDiffBaseBytes attempts to convert a byte slice to a DiffBase, without allocating
+// when name is valid.
+func ParseDiffBaseBytes(name []byte) (DiffBase, error) {
+	if x, ok := _DiffBaseValue[string(name)]; ok {
+		return x, nil
+	}
+	return DiffBase(0), fmt.Errorf("%s is %w", name, ErrInvalidDiffBase)
+}
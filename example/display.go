@@ -0,0 +1,18 @@
+//go:generate ../bin/go-enum --display -b example
+//go:generate ../bin/go-enum extract --lang en --lang fr --catalog display_catalog.go
+
+package example
+
+// Weather is an enumeration with translated names.
+/* ENUM(
+sunny
+cloudy
+rain // Falling water, used as a noun.
+snow
+)
+*/
+type Weather int
+
+// Discount is an enumeration whose names hold a percent sign, which Display prints as is.
+// ENUM(none="0%", half="50%", full="100%")
+type Discount string
@@ -0,0 +1,25 @@
+// Code generated by go-enum DO NOT EDIT.
+
+package example
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+func init() {
+	for _, m := range [...]struct{ tag, key, msg string }{
+		{"en", "Discount.0%", "0%%"},
+		{"en", "Discount.50%", "50%%"},
+		{"en", "Discount.100%", "100%%"},
+		{"en", "Weather.sunny", "sunny"},
+		{"en", "Weather.cloudy", "cloudy"},
+		{"en", "Weather.rain", "rain"},
+		{"en", "Weather.snow", "snow"},
+		{"fr", "Weather.sunny", "ensoleillé"},
+		{"fr", "Weather.cloudy", "nuageux"},
+		{"fr", "Weather.rain", "pluie"},
+	} {
+		_ = message.SetString(language.MustParse(m.tag), m.key, m.msg)
+	}
+}
@@ -0,0 +1,145 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+)
+
+const (
+	// DiscountNone is a Discount of type none.
+	DiscountNone Discount = "0%"
+	// DiscountHalf is a Discount of type half.
+	DiscountHalf Discount = "50%"
+	// DiscountFull is a Discount of type full.
+	DiscountFull Discount = "100%"
+)
+
+var ErrInvalidDiscount = errors.New("not a valid Discount")
+
+// String implements the Stringer interface.
+func (x Discount) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Discount) IsValid() bool {
+	_, err := ParseDiscount(string(x))
+	return err == nil
+}
+
+var _DiscountValue = map[string]Discount{
+	"0%":   DiscountNone,
+	"50%":  DiscountHalf,
+	"100%": DiscountFull,
+}
+
+// ParseDiscount attempts to convert a string to a Discount.
+func ParseDiscount(name string) (Discount, error) {
+	if x, ok := _DiscountValue[name]; ok {
+		return x, nil
+	}
+	return Discount(""), fmt.Errorf("%s is %w", name, ErrInvalidDiscount)
+}
+
+// ParseDiscountBytes attempts to convert a byte slice to a Discount, without allocating
+// when name is valid.
+func ParseDiscountBytes(name []byte) (Discount, error) {
+	if x, ok := _DiscountValue[string(name)]; ok {
+		return x, nil
+	}
+	return Discount(""), fmt.Errorf("%s is %w", name, ErrInvalidDiscount)
+}
+
+// Display returns the name of x translated for the language by the default message catalog, or
+// the name itself when there is no translation.  The messages are keyed Discount.name, and can
+// be extracted with the go-enum extract command.
+func (x Discount) Display(tag language.Tag) string {
+	name := x.String()
+	// The name is the fallback when there is no translation, and messages are format strings.
+	return message.NewPrinter(tag).Sprintf(message.Key("Discount."+name, strings.ReplaceAll(name, "%", "%%")))
+}
+
+const (
+	// WeatherSunny is a Weather of type Sunny.
+	WeatherSunny Weather = iota
+	// WeatherCloudy is a Weather of type Cloudy.
+	WeatherCloudy
+	// WeatherRain is a Weather of type Rain.
+	// Falling water, used as a noun.
+	WeatherRain
+	// WeatherSnow is a Weather of type Snow.
+	WeatherSnow
+)
+
+var ErrInvalidWeather = errors.New("not a valid Weather")
+
+const _WeatherName = "sunnycloudyrainsnow"
+
+var _WeatherMap = map[Weather]string{
+	WeatherSunny:  _WeatherName[0:5],
+	WeatherCloudy: _WeatherName[5:11],
+	WeatherRain:   _WeatherName[11:15],
+	WeatherSnow:   _WeatherName[15:19],
+}
+
+// String implements the Stringer interface.
+func (x Weather) String() string {
+	if str, ok := _WeatherMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Weather(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Weather) IsValid() bool {
+	_, ok := _WeatherMap[x]
+	return ok
+}
+
+var _WeatherValue = map[string]Weather{
+	_WeatherName[0:5]:   WeatherSunny,
+	_WeatherName[5:11]:  WeatherCloudy,
+	_WeatherName[11:15]: WeatherRain,
+	_WeatherName[15:19]: WeatherSnow,
+}
+
+// ParseWeather attempts to convert a string to a Weather.
+func ParseWeather(name string) (Weather, error) {
+	if x, ok := _WeatherValue[name]; ok {
+		return x, nil
+	}
+	return Weather(0), fmt.Errorf("%s is %w", name, ErrInvalidWeather)
+}
+
+// ParseWeatherBytes attempts to convert a byte slice to a Weather, without allocating
+// when name is valid.
+func ParseWeatherBytes(name []byte) (Weather, error) {
+	if x, ok := _WeatherValue[string(name)]; ok {
+		return x, nil
+	}
+	return Weather(0), fmt.Errorf("%s is %w", name, ErrInvalidWeather)
+}
+
+// Display returns the name of x translated for the language by the default message catalog, or
+// the name itself when there is no translation.  The messages are keyed Weather.name, and can
+// be extracted with the go-enum extract command.
+func (x Weather) Display(tag language.Tag) string {
+	name := x.String()
+	// The name is the fallback when there is no translation, and messages are format strings.
+	return message.NewPrinter(tag).Sprintf(message.Key("Weather."+name, strings.ReplaceAll(name, "%", "%%")))
+}
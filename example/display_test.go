@@ -0,0 +1,27 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+)
+
+func TestWeatherDisplay(t *testing.T) {
+	assert.Equal(t, "sunny", WeatherSunny.Display(language.English))
+	assert.Equal(t, "ensoleillé", WeatherSunny.Display(language.French))
+	assert.Equal(t, "pluie", WeatherRain.Display(language.MustParse("fr-CA")))
+	// Untranslated names and unknown languages fall back to the name
+	assert.Equal(t, "snow", WeatherSnow.Display(language.French))
+	assert.Equal(t, "cloudy", WeatherCloudy.Display(language.Japanese))
+	assert.Equal(t, "Weather(9)", Weather(9).Display(language.French))
+}
+
+func TestDiscountDisplay(t *testing.T) {
+	// The english names come from the catalog and the french ones fall back to the name
+	assert.Equal(t, "50%", DiscountHalf.Display(language.English))
+	assert.Equal(t, "100%", DiscountFull.Display(language.French))
+}
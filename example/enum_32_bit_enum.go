@@ -119,3 +119,12 @@ func ParseEnum32bit(name string) (Enum32bit, error) {
 	}
 	return Enum32bit(0), fmt.Errorf("%s is %w", name, ErrInvalidEnum32bit)
 }
+
+// ParseEnum32bitBytes attempts to convert a byte slice to a Enum32bit, without allocating
+// when name is valid.
+func ParseEnum32bitBytes(name []byte) (Enum32bit, error) {
+	if x, ok := _Enum32bitValue[string(name)]; ok {
+		return x, nil
+	}
+	return Enum32bit(0), fmt.Errorf("%s is %w", name, ErrInvalidEnum32bit)
+}
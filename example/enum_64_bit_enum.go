@@ -139,3 +139,12 @@ func ParseEnum64bit(name string) (Enum64bit, error) {
 	}
 	return Enum64bit(0), fmt.Errorf("%s is %w", name, ErrInvalidEnum64bit)
 }
+
+// ParseEnum64bitBytes attempts to convert a byte slice to a Enum64bit, without allocating
+// when name is valid.
+func ParseEnum64bitBytes(name []byte) (Enum64bit, error) {
+	if x, ok := _Enum64bitValue[string(name)]; ok {
+		return x, nil
+	}
+	return Enum64bit(0), fmt.Errorf("%s is %w", name, ErrInvalidEnum64bit)
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --enum-map --marshal --iter -b example
+
+package example
+
+// Weekday is an enumeration with an array backed map.
+// ENUM(monday=1, tuesday, wednesday, thursday, friday, _, sunday)
+type Weekday int
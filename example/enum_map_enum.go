@@ -0,0 +1,258 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	json "encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+const (
+	// WeekdayMonday is a Weekday of type Monday.
+	WeekdayMonday Weekday = iota + 1
+	// WeekdayTuesday is a Weekday of type Tuesday.
+	WeekdayTuesday
+	// WeekdayWednesday is a Weekday of type Wednesday.
+	WeekdayWednesday
+	// WeekdayThursday is a Weekday of type Thursday.
+	WeekdayThursday
+	// WeekdayFriday is a Weekday of type Friday.
+	WeekdayFriday
+	// Skipped value.
+	_
+	// WeekdaySunday is a Weekday of type Sunday.
+	WeekdaySunday
+)
+
+var ErrInvalidWeekday = errors.New("not a valid Weekday")
+
+const _WeekdayName = "mondaytuesdaywednesdaythursdayfridaysunday"
+
+var _WeekdayMap = map[Weekday]string{
+	WeekdayMonday:    _WeekdayName[0:6],
+	WeekdayTuesday:   _WeekdayName[6:13],
+	WeekdayWednesday: _WeekdayName[13:22],
+	WeekdayThursday:  _WeekdayName[22:30],
+	WeekdayFriday:    _WeekdayName[30:36],
+	WeekdaySunday:    _WeekdayName[36:42],
+}
+
+// String implements the Stringer interface.
+func (x Weekday) String() string {
+	if str, ok := _WeekdayMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Weekday(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Weekday) IsValid() bool {
+	_, ok := _WeekdayMap[x]
+	return ok
+}
+
+// WeekdayAll returns an iterator over the declared Weekday values, in declaration order.
+func WeekdayAll() iter.Seq[Weekday] {
+	return func(yield func(Weekday) bool) {
+		for _, x := range [...]Weekday{
+			WeekdayMonday,
+			WeekdayTuesday,
+			WeekdayWednesday,
+			WeekdayThursday,
+			WeekdayFriday,
+			WeekdaySunday,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// WeekdayEntries returns an iterator over the names and values of Weekday, in declaration order.
+func WeekdayEntries() iter.Seq2[string, Weekday] {
+	return func(yield func(string, Weekday) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value Weekday
+		}{
+			{_WeekdayName[0:6], WeekdayMonday},
+			{_WeekdayName[6:13], WeekdayTuesday},
+			{_WeekdayName[13:22], WeekdayWednesday},
+			{_WeekdayName[22:30], WeekdayThursday},
+			{_WeekdayName[30:36], WeekdayFriday},
+			{_WeekdayName[36:42], WeekdaySunday},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _WeekdayValue = map[string]Weekday{
+	_WeekdayName[0:6]:   WeekdayMonday,
+	_WeekdayName[6:13]:  WeekdayTuesday,
+	_WeekdayName[13:22]: WeekdayWednesday,
+	_WeekdayName[22:30]: WeekdayThursday,
+	_WeekdayName[30:36]: WeekdayFriday,
+	_WeekdayName[36:42]: WeekdaySunday,
+}
+
+// ParseWeekday attempts to convert a string to a Weekday.
+func ParseWeekday(name string) (Weekday, error) {
+	if x, ok := _WeekdayValue[name]; ok {
+		return x, nil
+	}
+	return Weekday(0), fmt.Errorf("%s is %w", name, ErrInvalidWeekday)
+}
+
+// ParseWeekdayBytes attempts to convert a byte slice to a Weekday, without allocating
+// when name is valid.
+func ParseWeekdayBytes(name []byte) (Weekday, error) {
+	if x, ok := _WeekdayValue[string(name)]; ok {
+		return x, nil
+	}
+	return Weekday(0), fmt.Errorf("%s is %w", name, ErrInvalidWeekday)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Weekday) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Weekday) UnmarshalText(text []byte) error {
+	tmp, err := ParseWeekdayBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Weekday) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+// WeekdayMap holds a V for each declared Weekday.  It is backed by an array indexed by
+// ordinal, so getting and setting values doesn't allocate.  The zero value is ready to use.
+type WeekdayMap[V any] struct {
+	values [6]V
+}
+
+var _WeekdayMapKeys = [...]Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySunday,
+}
+
+// mapIndexWeekday returns the position of x in WeekdayMap, or -1 if x is not declared.
+func mapIndexWeekday(x Weekday) int {
+	switch x {
+	case WeekdayMonday:
+		return 0
+	case WeekdayTuesday:
+		return 1
+	case WeekdayWednesday:
+		return 2
+	case WeekdayThursday:
+		return 3
+	case WeekdayFriday:
+		return 4
+	case WeekdaySunday:
+		return 5
+	}
+	return -1
+}
+
+// Get returns the value for x, or the zero value if x is not declared.
+func (m *WeekdayMap[V]) Get(x Weekday) V {
+	if i := mapIndexWeekday(x); i >= 0 {
+		return m.values[i]
+	}
+	var zero V
+	return zero
+}
+
+// Set stores v for x.  It returns false, without storing anything, if x is not declared.
+func (m *WeekdayMap[V]) Set(x Weekday, v V) bool {
+	i := mapIndexWeekday(x)
+	if i < 0 {
+		return false
+	}
+	m.values[i] = v
+	return true
+}
+
+// Range calls fn for each Weekday and its value, in declaration order, until fn returns false.
+func (m *WeekdayMap[V]) Range(fn func(Weekday, V) bool) {
+	for i, x := range _WeekdayMapKeys {
+		if !fn(x, m.values[i]) {
+			return
+		}
+	}
+}
+
+// All returns an iterator over each Weekday and its value, in declaration order.
+func (m *WeekdayMap[V]) All() iter.Seq2[Weekday, V] {
+	return m.Range
+}
+
+// MarshalJSON implements the json marshaller method, writing an object keyed by the names.
+func (m WeekdayMap[V]) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, x := range _WeekdayMapKeys {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		key, err := json.Marshal(x.String())
+		if err != nil {
+			return nil, err
+		}
+		val, err := json.Marshal(m.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		buf = append(buf, val...)
+	}
+	return append(buf, '}'), nil
+}
+
+// UnmarshalJSON implements the json unmarshaller method, reading an object keyed by the names.
+// Values for names that are not in the object are left as they are.
+func (m *WeekdayMap[V]) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for name, val := range raw {
+		x, err := ParseWeekday(name)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(val, &m.values[mapIndexWeekday(x)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
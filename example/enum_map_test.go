@@ -0,0 +1,62 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekdayMap(t *testing.T) {
+	var hours WeekdayMap[int]
+	assert.Equal(t, 0, hours.Get(WeekdayMonday))
+
+	assert.True(t, hours.Set(WeekdayMonday, 8))
+	assert.True(t, hours.Set(WeekdaySunday, 2))
+	assert.False(t, hours.Set(Weekday(6), 4))
+	assert.Equal(t, 8, hours.Get(WeekdayMonday))
+	assert.Equal(t, 2, hours.Get(WeekdaySunday))
+	assert.Equal(t, 0, hours.Get(Weekday(6)))
+
+	var days []Weekday
+	hours.Range(func(x Weekday, v int) bool {
+		days = append(days, x)
+		return x != WeekdayWednesday
+	})
+	assert.Equal(t, []Weekday{WeekdayMonday, WeekdayTuesday, WeekdayWednesday}, days)
+
+	total := 0
+	for _, v := range hours.All() {
+		total += v
+	}
+	assert.Equal(t, 10, total)
+}
+
+func TestWeekdayMapJSON(t *testing.T) {
+	var hours WeekdayMap[int]
+	hours.Set(WeekdayTuesday, 6)
+
+	b, err := json.Marshal(hours)
+	require.NoError(t, err)
+	assert.Equal(t, `{"monday":0,"tuesday":6,"wednesday":0,"thursday":0,"friday":0,"sunday":0}`, string(b))
+
+	var decoded WeekdayMap[int]
+	require.NoError(t, json.Unmarshal([]byte(`{"friday":7,"sunday":1}`), &decoded))
+	assert.Equal(t, 7, decoded.Get(WeekdayFriday))
+	assert.Equal(t, 1, decoded.Get(WeekdaySunday))
+	assert.Equal(t, 0, decoded.Get(WeekdayTuesday))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"saturday":1}`), &decoded))
+}
+
+func TestWeekdayMapAllocs(t *testing.T) {
+	var hours WeekdayMap[int]
+	allocs := testing.AllocsPerRun(100, func() {
+		hours.Set(WeekdayThursday, hours.Get(WeekdayThursday)+1)
+	})
+	assert.Zero(t, allocs)
+}
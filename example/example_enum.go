@@ -131,28 +131,66 @@ func (x Make) IsValid() bool {
 }
 
 var _MakeValue = map[string]Make{
-	_MakeName[0:6]:                    MakeToyota,
-	strings.ToLower(_MakeName[0:6]):   MakeToyota,
-	_MakeName[6:11]:                   MakeChevy,
-	strings.ToLower(_MakeName[6:11]):  MakeChevy,
-	_MakeName[11:15]:                  MakeFord,
-	strings.ToLower(_MakeName[11:15]): MakeFord,
-	_MakeName[15:20]:                  MakeTesla,
-	strings.ToLower(_MakeName[15:20]): MakeTesla,
-	_MakeName[20:27]:                  MakeHyundai,
-	strings.ToLower(_MakeName[20:27]): MakeHyundai,
-	_MakeName[27:33]:                  MakeNissan,
-	strings.ToLower(_MakeName[27:33]): MakeNissan,
-	_MakeName[33:39]:                  MakeJaguar,
-	strings.ToLower(_MakeName[33:39]): MakeJaguar,
-	_MakeName[39:43]:                  MakeAudi,
-	strings.ToLower(_MakeName[39:43]): MakeAudi,
-	_MakeName[43:46]:                  MakeBMW,
-	strings.ToLower(_MakeName[43:46]): MakeBMW,
-	_MakeName[46:59]:                  MakeMercedesBenz,
-	strings.ToLower(_MakeName[46:59]): MakeMercedesBenz,
-	_MakeName[59:69]:                  MakeVolkswagon,
-	strings.ToLower(_MakeName[59:69]): MakeVolkswagon,
+	_MakeName[0:6]:   MakeToyota,
+	_MakeName[6:11]:  MakeChevy,
+	_MakeName[11:15]: MakeFord,
+	_MakeName[15:20]: MakeTesla,
+	_MakeName[20:27]: MakeHyundai,
+	_MakeName[27:33]: MakeNissan,
+	_MakeName[33:39]: MakeJaguar,
+	_MakeName[39:43]: MakeAudi,
+	_MakeName[43:46]: MakeBMW,
+	_MakeName[46:59]: MakeMercedesBenz,
+	_MakeName[59:69]: MakeVolkswagon,
+}
+
+// foldMake finds the Make for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldMake(name string) (Make, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "BMW") {
+			return MakeBMW, true
+		}
+	case 4:
+		if strings.EqualFold(name, "Ford") {
+			return MakeFord, true
+		}
+		if strings.EqualFold(name, "Audi") {
+			return MakeAudi, true
+		}
+	case 5:
+		if strings.EqualFold(name, "Chevy") {
+			return MakeChevy, true
+		}
+		if strings.EqualFold(name, "Tesla") {
+			return MakeTesla, true
+		}
+	case 6:
+		if strings.EqualFold(name, "Toyota") {
+			return MakeToyota, true
+		}
+		if strings.EqualFold(name, "Nissan") {
+			return MakeNissan, true
+		}
+		if strings.EqualFold(name, "Jaguar") {
+			return MakeJaguar, true
+		}
+	case 7:
+		if strings.EqualFold(name, "Hyundai") {
+			return MakeHyundai, true
+		}
+	case 10:
+		if strings.EqualFold(name, "Volkswagon") {
+			return MakeVolkswagon, true
+		}
+	case 13:
+		if strings.EqualFold(name, "Mercedes-Benz") {
+			return MakeMercedesBenz, true
+		}
+	}
+	return 0, false
 }
 
 // ParseMake attempts to convert a string to a Make.
@@ -160,8 +198,20 @@ func ParseMake(name string) (Make, error) {
 	if x, ok := _MakeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _MakeValue[strings.ToLower(name)]; ok {
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldMake(name); ok {
+		return x, nil
+	}
+	return Make(0), fmt.Errorf("%s is %w", name, ErrInvalidMake)
+}
+
+// ParseMakeBytes attempts to convert a byte slice to a Make, without allocating
+// when name is valid.
+func ParseMakeBytes(name []byte) (Make, error) {
+	if x, ok := _MakeValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := foldMake(string(name)); ok {
 		return x, nil
 	}
 	return Make(0), fmt.Errorf("%s is %w", name, ErrInvalidMake)
@@ -174,8 +224,7 @@ func (x Make) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *Make) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseMake(name)
+	tmp, err := ParseMakeBytes(text)
 	if err != nil {
 		return err
 	}
@@ -280,18 +329,44 @@ func (x NoZeros) IsValid() bool {
 }
 
 var _NoZerosValue = map[string]NoZeros{
-	_NoZerosName[0:5]:                    NoZerosStart,
-	strings.ToLower(_NoZerosName[0:5]):   NoZerosStart,
-	_NoZerosName[5:11]:                   NoZerosMiddle,
-	strings.ToLower(_NoZerosName[5:11]):  NoZerosMiddle,
-	_NoZerosName[11:14]:                  NoZerosEnd,
-	strings.ToLower(_NoZerosName[11:14]): NoZerosEnd,
-	_NoZerosName[14:16]:                  NoZerosPs,
-	strings.ToLower(_NoZerosName[14:16]): NoZerosPs,
-	_NoZerosName[16:19]:                  NoZerosPps,
-	strings.ToLower(_NoZerosName[16:19]): NoZerosPps,
-	_NoZerosName[19:23]:                  NoZerosPpps,
-	strings.ToLower(_NoZerosName[19:23]): NoZerosPpps,
+	_NoZerosName[0:5]:   NoZerosStart,
+	_NoZerosName[5:11]:  NoZerosMiddle,
+	_NoZerosName[11:14]: NoZerosEnd,
+	_NoZerosName[14:16]: NoZerosPs,
+	_NoZerosName[16:19]: NoZerosPps,
+	_NoZerosName[19:23]: NoZerosPpps,
+}
+
+// foldNoZeros finds the NoZeros for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldNoZeros(name string) (NoZeros, bool) {
+	switch len(name) {
+	case 2:
+		if strings.EqualFold(name, "ps") {
+			return NoZerosPs, true
+		}
+	case 3:
+		if strings.EqualFold(name, "end") {
+			return NoZerosEnd, true
+		}
+		if strings.EqualFold(name, "pps") {
+			return NoZerosPps, true
+		}
+	case 4:
+		if strings.EqualFold(name, "ppps") {
+			return NoZerosPpps, true
+		}
+	case 5:
+		if strings.EqualFold(name, "start") {
+			return NoZerosStart, true
+		}
+	case 6:
+		if strings.EqualFold(name, "middle") {
+			return NoZerosMiddle, true
+		}
+	}
+	return 0, false
 }
 
 // ParseNoZeros attempts to convert a string to a NoZeros.
@@ -299,8 +374,20 @@ func ParseNoZeros(name string) (NoZeros, error) {
 	if x, ok := _NoZerosValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _NoZerosValue[strings.ToLower(name)]; ok {
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldNoZeros(name); ok {
+		return x, nil
+	}
+	return NoZeros(0), fmt.Errorf("%s is %w", name, ErrInvalidNoZeros)
+}
+
+// ParseNoZerosBytes attempts to convert a byte slice to a NoZeros, without allocating
+// when name is valid.
+func ParseNoZerosBytes(name []byte) (NoZeros, error) {
+	if x, ok := _NoZerosValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := foldNoZeros(string(name)); ok {
 		return x, nil
 	}
 	return NoZeros(0), fmt.Errorf("%s is %w", name, ErrInvalidNoZeros)
@@ -313,8 +400,7 @@ func (x NoZeros) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *NoZeros) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseNoZeros(name)
+	tmp, err := ParseNoZerosBytes(text)
 	if err != nil {
 		return err
 	}
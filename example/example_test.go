@@ -272,3 +272,32 @@ func BenchmarkMakeParse(b *testing.B) {
 		})
 	}
 }
+
+func TestMakeParseBytes(t *testing.T) {
+	for _, input := range []string{"Ford", "ford", "FORD", "fOrD"} {
+		x, err := ParseMakeBytes([]byte(input))
+		require.NoError(t, err, input)
+		assert.Equal(t, MakeFord, x, input)
+	}
+
+	_, err := ParseMakeBytes([]byte("Ferrari"))
+	assert.ErrorIs(t, err, ErrInvalidMake)
+	assert.EqualError(t, err, "Ferrari is not a valid Make, try [Toyota, Chevy, Ford, Tesla, Hyundai, Nissan, Jaguar, Audi, BMW, Mercedes-Benz, Volkswagon]")
+}
+
+func TestMakeCaseInsensitiveAllocations(t *testing.T) {
+	name := "mercedes-BENZ"
+	text := []byte(name)
+	var x Make
+	allocs := testing.AllocsPerRun(100, func() {
+		x, _ = ParseMake(name)
+	})
+	assert.Equal(t, MakeMercedesBenz, x)
+	assert.Zero(t, allocs, "ParseMake")
+
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = x.UnmarshalText(text)
+	})
+	assert.Equal(t, MakeMercedesBenz, x)
+	assert.Zero(t, allocs, "UnmarshalText")
+}
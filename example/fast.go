@@ -0,0 +1,30 @@
+//go:generate ../bin/go-enum --fast --nocase --marshal --benchmark -b example
+
+package example
+
+// Opcode is a dense enumeration, so String indexes an array of offsets.
+// ENUM(nop, load, store, _, add, sub, mul, div)
+type Opcode uint8
+
+// LogLevel is a dense enumeration that starts below zero.
+// ENUM(trace=-2, debug, info, warn, error)
+type LogLevel int
+
+// Delta is a dense enumeration that starts at the lower bound of its type.
+// ENUM(min=-128, lower, low)
+type Delta int8
+
+// Port is a sparse enumeration, so String uses a switch.
+// ENUM(ssh=22, http=80, https=443, postgres=5432)
+type Port int
+
+// Element is a larger enumeration, so Parse uses a minimal perfect hash.
+/*
+ENUM(
+	hydrogen, helium, lithium, beryllium, boron, carbon, nitrogen, oxygen, fluorine, neon,
+	sodium, magnesium, aluminium, silicon, phosphorus, sulfur, chlorine, argon, potassium, calcium,
+	scandium, titanium, vanadium, chromium, manganese, iron, cobalt, nickel, copper, zinc,
+	Gallium, Germanium, Arsenic, Selenium, Bromine, Krypton
+)
+*/
+type Element string
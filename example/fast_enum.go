@@ -0,0 +1,991 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DeltaMin is a Delta of type Min.
+	DeltaMin Delta = iota + -128
+	// DeltaLower is a Delta of type Lower.
+	DeltaLower
+	// DeltaLow is a Delta of type Low.
+	DeltaLow
+)
+
+var ErrInvalidDelta = errors.New("not a valid Delta")
+
+const _DeltaName = "minlowerlow"
+
+var _DeltaMap = map[Delta]string{
+	DeltaMin:   _DeltaName[0:3],
+	DeltaLower: _DeltaName[3:8],
+	DeltaLow:   _DeltaName[8:11],
+}
+
+var _DeltaIndex = [...]uint8{0, 3, 8, 11}
+
+// String implements the Stringer interface.
+func (x Delta) String() string {
+	if !x.IsValid() {
+		return fmt.Sprintf("Delta(%d)", x)
+	}
+	i := uint64(x) + 128
+	return _DeltaName[_DeltaIndex[i]:_DeltaIndex[i+1]]
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Delta) IsValid() bool {
+	// The index is computed in uint64, so it can't overflow the type and values below the first wrap around
+	i := uint64(x) + 128
+	return i < uint64(len(_DeltaIndex)-1)
+}
+
+var _DeltaValue = map[string]Delta{
+	_DeltaName[0:3]:  DeltaMin,
+	_DeltaName[3:8]:  DeltaLower,
+	_DeltaName[8:11]: DeltaLow,
+}
+
+// lookupDelta finds the Delta for name.
+func lookupDelta(name string) (Delta, bool) {
+	switch name {
+	case "min":
+		return DeltaMin, true
+	case "lower":
+		return DeltaLower, true
+	case "low":
+		return DeltaLow, true
+	}
+	return 0, false
+}
+
+// foldDelta finds the Delta for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldDelta(name string) (Delta, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "min") {
+			return DeltaMin, true
+		}
+		if strings.EqualFold(name, "low") {
+			return DeltaLow, true
+		}
+	case 5:
+		if strings.EqualFold(name, "lower") {
+			return DeltaLower, true
+		}
+	}
+	return 0, false
+}
+
+// ParseDelta attempts to convert a string to a Delta.
+func ParseDelta(name string) (Delta, error) {
+	if x, ok := lookupDelta(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldDelta(name); ok {
+		return x, nil
+	}
+	return Delta(0), fmt.Errorf("%s is %w", name, ErrInvalidDelta)
+}
+
+// ParseDeltaBytes attempts to convert a byte slice to a Delta, without allocating
+// when name is valid.
+func ParseDeltaBytes(name []byte) (Delta, error) {
+	if x, ok := lookupDelta(string(name)); ok {
+		return x, nil
+	}
+	if x, ok := foldDelta(string(name)); ok {
+		return x, nil
+	}
+	return Delta(0), fmt.Errorf("%s is %w", name, ErrInvalidDelta)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Delta) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Delta) UnmarshalText(text []byte) error {
+	tmp, err := ParseDeltaBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Delta) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// ElementHydrogen is a Element of type hydrogen.
+	ElementHydrogen Element = "hydrogen"
+	// ElementHelium is a Element of type helium.
+	ElementHelium Element = "helium"
+	// ElementLithium is a Element of type lithium.
+	ElementLithium Element = "lithium"
+	// ElementBeryllium is a Element of type beryllium.
+	ElementBeryllium Element = "beryllium"
+	// ElementBoron is a Element of type boron.
+	ElementBoron Element = "boron"
+	// ElementCarbon is a Element of type carbon.
+	ElementCarbon Element = "carbon"
+	// ElementNitrogen is a Element of type nitrogen.
+	ElementNitrogen Element = "nitrogen"
+	// ElementOxygen is a Element of type oxygen.
+	ElementOxygen Element = "oxygen"
+	// ElementFluorine is a Element of type fluorine.
+	ElementFluorine Element = "fluorine"
+	// ElementNeon is a Element of type neon.
+	ElementNeon Element = "neon"
+	// ElementSodium is a Element of type sodium.
+	ElementSodium Element = "sodium"
+	// ElementMagnesium is a Element of type magnesium.
+	ElementMagnesium Element = "magnesium"
+	// ElementAluminium is a Element of type aluminium.
+	ElementAluminium Element = "aluminium"
+	// ElementSilicon is a Element of type silicon.
+	ElementSilicon Element = "silicon"
+	// ElementPhosphorus is a Element of type phosphorus.
+	ElementPhosphorus Element = "phosphorus"
+	// ElementSulfur is a Element of type sulfur.
+	ElementSulfur Element = "sulfur"
+	// ElementChlorine is a Element of type chlorine.
+	ElementChlorine Element = "chlorine"
+	// ElementArgon is a Element of type argon.
+	ElementArgon Element = "argon"
+	// ElementPotassium is a Element of type potassium.
+	ElementPotassium Element = "potassium"
+	// ElementCalcium is a Element of type calcium.
+	ElementCalcium Element = "calcium"
+	// ElementScandium is a Element of type scandium.
+	ElementScandium Element = "scandium"
+	// ElementTitanium is a Element of type titanium.
+	ElementTitanium Element = "titanium"
+	// ElementVanadium is a Element of type vanadium.
+	ElementVanadium Element = "vanadium"
+	// ElementChromium is a Element of type chromium.
+	ElementChromium Element = "chromium"
+	// ElementManganese is a Element of type manganese.
+	ElementManganese Element = "manganese"
+	// ElementIron is a Element of type iron.
+	ElementIron Element = "iron"
+	// ElementCobalt is a Element of type cobalt.
+	ElementCobalt Element = "cobalt"
+	// ElementNickel is a Element of type nickel.
+	ElementNickel Element = "nickel"
+	// ElementCopper is a Element of type copper.
+	ElementCopper Element = "copper"
+	// ElementZinc is a Element of type zinc.
+	ElementZinc Element = "zinc"
+	// ElementGallium is a Element of type Gallium.
+	ElementGallium Element = "Gallium"
+	// ElementGermanium is a Element of type Germanium.
+	ElementGermanium Element = "Germanium"
+	// ElementArsenic is a Element of type Arsenic.
+	ElementArsenic Element = "Arsenic"
+	// ElementSelenium is a Element of type Selenium.
+	ElementSelenium Element = "Selenium"
+	// ElementBromine is a Element of type Bromine.
+	ElementBromine Element = "Bromine"
+	// ElementKrypton is a Element of type Krypton.
+	ElementKrypton Element = "Krypton"
+)
+
+var ErrInvalidElement = errors.New("not a valid Element")
+
+// String implements the Stringer interface.
+func (x Element) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Element) IsValid() bool {
+	_, err := ParseElement(string(x))
+	return err == nil
+}
+
+var _ElementValue = map[string]Element{
+	"hydrogen":   ElementHydrogen,
+	"helium":     ElementHelium,
+	"lithium":    ElementLithium,
+	"beryllium":  ElementBeryllium,
+	"boron":      ElementBoron,
+	"carbon":     ElementCarbon,
+	"nitrogen":   ElementNitrogen,
+	"oxygen":     ElementOxygen,
+	"fluorine":   ElementFluorine,
+	"neon":       ElementNeon,
+	"sodium":     ElementSodium,
+	"magnesium":  ElementMagnesium,
+	"aluminium":  ElementAluminium,
+	"silicon":    ElementSilicon,
+	"phosphorus": ElementPhosphorus,
+	"sulfur":     ElementSulfur,
+	"chlorine":   ElementChlorine,
+	"argon":      ElementArgon,
+	"potassium":  ElementPotassium,
+	"calcium":    ElementCalcium,
+	"scandium":   ElementScandium,
+	"titanium":   ElementTitanium,
+	"vanadium":   ElementVanadium,
+	"chromium":   ElementChromium,
+	"manganese":  ElementManganese,
+	"iron":       ElementIron,
+	"cobalt":     ElementCobalt,
+	"nickel":     ElementNickel,
+	"copper":     ElementCopper,
+	"zinc":       ElementZinc,
+	"Gallium":    ElementGallium,
+	"Germanium":  ElementGermanium,
+	"Arsenic":    ElementArsenic,
+	"Selenium":   ElementSelenium,
+	"Bromine":    ElementBromine,
+	"Krypton":    ElementKrypton,
+}
+
+var _ElementLookupSeeds = [...]uint32{15, 92, 24, 3, 20, 7, 27, 225, 906}
+
+var _ElementLookupKeys = [...]string{
+	"zinc",
+	"chlorine",
+	"silicon",
+	"oxygen",
+	"fluorine",
+	"lithium",
+	"nickel",
+	"boron",
+	"cobalt",
+	"nitrogen",
+	"helium",
+	"potassium",
+	"argon",
+	"sodium",
+	"Germanium",
+	"aluminium",
+	"Gallium",
+	"calcium",
+	"phosphorus",
+	"Bromine",
+	"chromium",
+	"iron",
+	"vanadium",
+	"magnesium",
+	"sulfur",
+	"beryllium",
+	"hydrogen",
+	"Selenium",
+	"copper",
+	"Krypton",
+	"carbon",
+	"titanium",
+	"Arsenic",
+	"neon",
+	"manganese",
+	"scandium",
+}
+
+var _ElementLookupValues = [...]Element{
+	ElementZinc,
+	ElementChlorine,
+	ElementSilicon,
+	ElementOxygen,
+	ElementFluorine,
+	ElementLithium,
+	ElementNickel,
+	ElementBoron,
+	ElementCobalt,
+	ElementNitrogen,
+	ElementHelium,
+	ElementPotassium,
+	ElementArgon,
+	ElementSodium,
+	ElementGermanium,
+	ElementAluminium,
+	ElementGallium,
+	ElementCalcium,
+	ElementPhosphorus,
+	ElementBromine,
+	ElementChromium,
+	ElementIron,
+	ElementVanadium,
+	ElementMagnesium,
+	ElementSulfur,
+	ElementBeryllium,
+	ElementHydrogen,
+	ElementSelenium,
+	ElementCopper,
+	ElementKrypton,
+	ElementCarbon,
+	ElementTitanium,
+	ElementArsenic,
+	ElementNeon,
+	ElementManganese,
+	ElementScandium,
+}
+
+// mixElement seeds an FNV-1a hash and runs it through the murmur3 finalizer.
+func mixElement(h, seed uint32) uint32 {
+	h ^= seed
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// lookupElement finds the Element for name using a minimal perfect hash.
+func lookupElement(name string) (Element, bool) {
+	h := uint32(2166136261)
+	for i := 0; i < len(name); i++ {
+		h ^= uint32(name[i])
+		h *= 16777619
+	}
+	seed := _ElementLookupSeeds[mixElement(h, 0)%uint32(len(_ElementLookupSeeds))]
+	i := mixElement(h, seed) % uint32(len(_ElementLookupKeys))
+	if _ElementLookupKeys[i] != name {
+		return "", false
+	}
+	return _ElementLookupValues[i], true
+}
+
+// foldElement finds the Element for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldElement(name string) (Element, bool) {
+	switch len(name) {
+	case 4:
+		if strings.EqualFold(name, "neon") {
+			return ElementNeon, true
+		}
+		if strings.EqualFold(name, "iron") {
+			return ElementIron, true
+		}
+		if strings.EqualFold(name, "zinc") {
+			return ElementZinc, true
+		}
+	case 5:
+		if strings.EqualFold(name, "boron") {
+			return ElementBoron, true
+		}
+		if strings.EqualFold(name, "argon") {
+			return ElementArgon, true
+		}
+	case 6:
+		if strings.EqualFold(name, "helium") {
+			return ElementHelium, true
+		}
+		if strings.EqualFold(name, "carbon") {
+			return ElementCarbon, true
+		}
+		if strings.EqualFold(name, "oxygen") {
+			return ElementOxygen, true
+		}
+		if strings.EqualFold(name, "sodium") {
+			return ElementSodium, true
+		}
+		if strings.EqualFold(name, "sulfur") {
+			return ElementSulfur, true
+		}
+		if strings.EqualFold(name, "cobalt") {
+			return ElementCobalt, true
+		}
+		if strings.EqualFold(name, "nickel") {
+			return ElementNickel, true
+		}
+		if strings.EqualFold(name, "copper") {
+			return ElementCopper, true
+		}
+	case 7:
+		if strings.EqualFold(name, "lithium") {
+			return ElementLithium, true
+		}
+		if strings.EqualFold(name, "silicon") {
+			return ElementSilicon, true
+		}
+		if strings.EqualFold(name, "calcium") {
+			return ElementCalcium, true
+		}
+		if strings.EqualFold(name, "Gallium") {
+			return ElementGallium, true
+		}
+		if strings.EqualFold(name, "Arsenic") {
+			return ElementArsenic, true
+		}
+		if strings.EqualFold(name, "Bromine") {
+			return ElementBromine, true
+		}
+		if strings.EqualFold(name, "Krypton") {
+			return ElementKrypton, true
+		}
+	case 8:
+		if strings.EqualFold(name, "hydrogen") {
+			return ElementHydrogen, true
+		}
+		if strings.EqualFold(name, "nitrogen") {
+			return ElementNitrogen, true
+		}
+		if strings.EqualFold(name, "fluorine") {
+			return ElementFluorine, true
+		}
+		if strings.EqualFold(name, "chlorine") {
+			return ElementChlorine, true
+		}
+		if strings.EqualFold(name, "scandium") {
+			return ElementScandium, true
+		}
+		if strings.EqualFold(name, "titanium") {
+			return ElementTitanium, true
+		}
+		if strings.EqualFold(name, "vanadium") {
+			return ElementVanadium, true
+		}
+		if strings.EqualFold(name, "chromium") {
+			return ElementChromium, true
+		}
+		if strings.EqualFold(name, "Selenium") {
+			return ElementSelenium, true
+		}
+	case 9:
+		if strings.EqualFold(name, "beryllium") {
+			return ElementBeryllium, true
+		}
+		if strings.EqualFold(name, "magnesium") {
+			return ElementMagnesium, true
+		}
+		if strings.EqualFold(name, "aluminium") {
+			return ElementAluminium, true
+		}
+		if strings.EqualFold(name, "potassium") {
+			return ElementPotassium, true
+		}
+		if strings.EqualFold(name, "manganese") {
+			return ElementManganese, true
+		}
+		if strings.EqualFold(name, "Germanium") {
+			return ElementGermanium, true
+		}
+	case 10:
+		if strings.EqualFold(name, "phosphorus") {
+			return ElementPhosphorus, true
+		}
+	}
+	return "", false
+}
+
+// ParseElement attempts to convert a string to a Element.
+func ParseElement(name string) (Element, error) {
+	if x, ok := lookupElement(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldElement(name); ok {
+		return x, nil
+	}
+	return Element(""), fmt.Errorf("%s is %w", name, ErrInvalidElement)
+}
+
+// ParseElementBytes attempts to convert a byte slice to a Element, without allocating
+// when name is valid.
+func ParseElementBytes(name []byte) (Element, error) {
+	if x, ok := lookupElement(string(name)); ok {
+		return x, nil
+	}
+	if x, ok := foldElement(string(name)); ok {
+		return x, nil
+	}
+	return Element(""), fmt.Errorf("%s is %w", name, ErrInvalidElement)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Element) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Element) UnmarshalText(text []byte) error {
+	tmp, err := ParseElementBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Element) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// LogLevelTrace is a LogLevel of type Trace.
+	LogLevelTrace LogLevel = iota + -2
+	// LogLevelDebug is a LogLevel of type Debug.
+	LogLevelDebug
+	// LogLevelInfo is a LogLevel of type Info.
+	LogLevelInfo
+	// LogLevelWarn is a LogLevel of type Warn.
+	LogLevelWarn
+	// LogLevelError is a LogLevel of type Error.
+	LogLevelError
+)
+
+var ErrInvalidLogLevel = errors.New("not a valid LogLevel")
+
+const _LogLevelName = "tracedebuginfowarnerror"
+
+var _LogLevelMap = map[LogLevel]string{
+	LogLevelTrace: _LogLevelName[0:5],
+	LogLevelDebug: _LogLevelName[5:10],
+	LogLevelInfo:  _LogLevelName[10:14],
+	LogLevelWarn:  _LogLevelName[14:18],
+	LogLevelError: _LogLevelName[18:23],
+}
+
+var _LogLevelIndex = [...]uint8{0, 5, 10, 14, 18, 23}
+
+// String implements the Stringer interface.
+func (x LogLevel) String() string {
+	if !x.IsValid() {
+		return fmt.Sprintf("LogLevel(%d)", x)
+	}
+	i := uint64(x) + 2
+	return _LogLevelName[_LogLevelIndex[i]:_LogLevelIndex[i+1]]
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x LogLevel) IsValid() bool {
+	// The index is computed in uint64, so it can't overflow the type and values below the first wrap around
+	i := uint64(x) + 2
+	return i < uint64(len(_LogLevelIndex)-1)
+}
+
+var _LogLevelValue = map[string]LogLevel{
+	_LogLevelName[0:5]:   LogLevelTrace,
+	_LogLevelName[5:10]:  LogLevelDebug,
+	_LogLevelName[10:14]: LogLevelInfo,
+	_LogLevelName[14:18]: LogLevelWarn,
+	_LogLevelName[18:23]: LogLevelError,
+}
+
+// lookupLogLevel finds the LogLevel for name.
+func lookupLogLevel(name string) (LogLevel, bool) {
+	switch name {
+	case "trace":
+		return LogLevelTrace, true
+	case "debug":
+		return LogLevelDebug, true
+	case "info":
+		return LogLevelInfo, true
+	case "warn":
+		return LogLevelWarn, true
+	case "error":
+		return LogLevelError, true
+	}
+	return 0, false
+}
+
+// foldLogLevel finds the LogLevel for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldLogLevel(name string) (LogLevel, bool) {
+	switch len(name) {
+	case 4:
+		if strings.EqualFold(name, "info") {
+			return LogLevelInfo, true
+		}
+		if strings.EqualFold(name, "warn") {
+			return LogLevelWarn, true
+		}
+	case 5:
+		if strings.EqualFold(name, "trace") {
+			return LogLevelTrace, true
+		}
+		if strings.EqualFold(name, "debug") {
+			return LogLevelDebug, true
+		}
+		if strings.EqualFold(name, "error") {
+			return LogLevelError, true
+		}
+	}
+	return 0, false
+}
+
+// ParseLogLevel attempts to convert a string to a LogLevel.
+func ParseLogLevel(name string) (LogLevel, error) {
+	if x, ok := lookupLogLevel(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldLogLevel(name); ok {
+		return x, nil
+	}
+	return LogLevel(0), fmt.Errorf("%s is %w", name, ErrInvalidLogLevel)
+}
+
+// ParseLogLevelBytes attempts to convert a byte slice to a LogLevel, without allocating
+// when name is valid.
+func ParseLogLevelBytes(name []byte) (LogLevel, error) {
+	if x, ok := lookupLogLevel(string(name)); ok {
+		return x, nil
+	}
+	if x, ok := foldLogLevel(string(name)); ok {
+		return x, nil
+	}
+	return LogLevel(0), fmt.Errorf("%s is %w", name, ErrInvalidLogLevel)
+}
+
+// MarshalText implements the text marshaller method.
+func (x LogLevel) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *LogLevel) UnmarshalText(text []byte) error {
+	tmp, err := ParseLogLevelBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *LogLevel) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// OpcodeNop is a Opcode of type Nop.
+	OpcodeNop Opcode = iota
+	// OpcodeLoad is a Opcode of type Load.
+	OpcodeLoad
+	// OpcodeStore is a Opcode of type Store.
+	OpcodeStore
+	// Skipped value.
+	_
+	// OpcodeAdd is a Opcode of type Add.
+	OpcodeAdd
+	// OpcodeSub is a Opcode of type Sub.
+	OpcodeSub
+	// OpcodeMul is a Opcode of type Mul.
+	OpcodeMul
+	// OpcodeDiv is a Opcode of type Div.
+	OpcodeDiv
+)
+
+var ErrInvalidOpcode = errors.New("not a valid Opcode")
+
+const _OpcodeName = "noploadstoreaddsubmuldiv"
+
+var _OpcodeMap = map[Opcode]string{
+	OpcodeNop:   _OpcodeName[0:3],
+	OpcodeLoad:  _OpcodeName[3:7],
+	OpcodeStore: _OpcodeName[7:12],
+	OpcodeAdd:   _OpcodeName[12:15],
+	OpcodeSub:   _OpcodeName[15:18],
+	OpcodeMul:   _OpcodeName[18:21],
+	OpcodeDiv:   _OpcodeName[21:24],
+}
+
+var _OpcodeIndex = [...]uint8{0, 3, 7, 12, 12, 15, 18, 21, 24}
+
+// String implements the Stringer interface.
+func (x Opcode) String() string {
+	if !x.IsValid() {
+		return fmt.Sprintf("Opcode(%d)", x)
+	}
+	i := uint64(x)
+	return _OpcodeName[_OpcodeIndex[i]:_OpcodeIndex[i+1]]
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Opcode) IsValid() bool {
+	// The index is computed in uint64, so it can't overflow the type and values below the first wrap around
+	i := uint64(x)
+	return i < uint64(len(_OpcodeIndex)-1) && _OpcodeIndex[i] != _OpcodeIndex[i+1]
+}
+
+var _OpcodeValue = map[string]Opcode{
+	_OpcodeName[0:3]:   OpcodeNop,
+	_OpcodeName[3:7]:   OpcodeLoad,
+	_OpcodeName[7:12]:  OpcodeStore,
+	_OpcodeName[12:15]: OpcodeAdd,
+	_OpcodeName[15:18]: OpcodeSub,
+	_OpcodeName[18:21]: OpcodeMul,
+	_OpcodeName[21:24]: OpcodeDiv,
+}
+
+// lookupOpcode finds the Opcode for name.
+func lookupOpcode(name string) (Opcode, bool) {
+	switch name {
+	case "nop":
+		return OpcodeNop, true
+	case "load":
+		return OpcodeLoad, true
+	case "store":
+		return OpcodeStore, true
+	case "add":
+		return OpcodeAdd, true
+	case "sub":
+		return OpcodeSub, true
+	case "mul":
+		return OpcodeMul, true
+	case "div":
+		return OpcodeDiv, true
+	}
+	return 0, false
+}
+
+// foldOpcode finds the Opcode for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldOpcode(name string) (Opcode, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "nop") {
+			return OpcodeNop, true
+		}
+		if strings.EqualFold(name, "add") {
+			return OpcodeAdd, true
+		}
+		if strings.EqualFold(name, "sub") {
+			return OpcodeSub, true
+		}
+		if strings.EqualFold(name, "mul") {
+			return OpcodeMul, true
+		}
+		if strings.EqualFold(name, "div") {
+			return OpcodeDiv, true
+		}
+	case 4:
+		if strings.EqualFold(name, "load") {
+			return OpcodeLoad, true
+		}
+	case 5:
+		if strings.EqualFold(name, "store") {
+			return OpcodeStore, true
+		}
+	}
+	return 0, false
+}
+
+// ParseOpcode attempts to convert a string to a Opcode.
+func ParseOpcode(name string) (Opcode, error) {
+	if x, ok := lookupOpcode(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldOpcode(name); ok {
+		return x, nil
+	}
+	return Opcode(0), fmt.Errorf("%s is %w", name, ErrInvalidOpcode)
+}
+
+// ParseOpcodeBytes attempts to convert a byte slice to a Opcode, without allocating
+// when name is valid.
+func ParseOpcodeBytes(name []byte) (Opcode, error) {
+	if x, ok := lookupOpcode(string(name)); ok {
+		return x, nil
+	}
+	if x, ok := foldOpcode(string(name)); ok {
+		return x, nil
+	}
+	return Opcode(0), fmt.Errorf("%s is %w", name, ErrInvalidOpcode)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Opcode) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Opcode) UnmarshalText(text []byte) error {
+	tmp, err := ParseOpcodeBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Opcode) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// PortSsh is a Port of type Ssh.
+	PortSsh Port = iota + 22
+	// PortHttp is a Port of type Http.
+	PortHttp Port = iota + 79
+	// PortHttps is a Port of type Https.
+	PortHttps Port = iota + 441
+	// PortPostgres is a Port of type Postgres.
+	PortPostgres Port = iota + 5429
+)
+
+var ErrInvalidPort = errors.New("not a valid Port")
+
+const _PortName = "sshhttphttpspostgres"
+
+var _PortMap = map[Port]string{
+	PortSsh:      _PortName[0:3],
+	PortHttp:     _PortName[3:7],
+	PortHttps:    _PortName[7:12],
+	PortPostgres: _PortName[12:20],
+}
+
+// String implements the Stringer interface.
+func (x Port) String() string {
+	switch x {
+	case PortSsh:
+		return _PortName[0:3]
+	case PortHttp:
+		return _PortName[3:7]
+	case PortHttps:
+		return _PortName[7:12]
+	case PortPostgres:
+		return _PortName[12:20]
+	}
+	return fmt.Sprintf("Port(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Port) IsValid() bool {
+	switch x {
+	case PortSsh, PortHttp, PortHttps, PortPostgres:
+		return true
+	}
+	return false
+}
+
+var _PortValue = map[string]Port{
+	_PortName[0:3]:   PortSsh,
+	_PortName[3:7]:   PortHttp,
+	_PortName[7:12]:  PortHttps,
+	_PortName[12:20]: PortPostgres,
+}
+
+// lookupPort finds the Port for name.
+func lookupPort(name string) (Port, bool) {
+	switch name {
+	case "ssh":
+		return PortSsh, true
+	case "http":
+		return PortHttp, true
+	case "https":
+		return PortHttps, true
+	case "postgres":
+		return PortPostgres, true
+	}
+	return 0, false
+}
+
+// foldPort finds the Port for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldPort(name string) (Port, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "ssh") {
+			return PortSsh, true
+		}
+	case 4:
+		if strings.EqualFold(name, "http") {
+			return PortHttp, true
+		}
+	case 5:
+		if strings.EqualFold(name, "https") {
+			return PortHttps, true
+		}
+	case 8:
+		if strings.EqualFold(name, "postgres") {
+			return PortPostgres, true
+		}
+	}
+	return 0, false
+}
+
+// ParsePort attempts to convert a string to a Port.
+func ParsePort(name string) (Port, error) {
+	if x, ok := lookupPort(name); ok {
+		return x, nil
+	}
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldPort(name); ok {
+		return x, nil
+	}
+	return Port(0), fmt.Errorf("%s is %w", name, ErrInvalidPort)
+}
+
+// ParsePortBytes attempts to convert a byte slice to a Port, without allocating
+// when name is valid.
+func ParsePortBytes(name []byte) (Port, error) {
+	if x, ok := lookupPort(string(name)); ok {
+		return x, nil
+	}
+	if x, ok := foldPort(string(name)); ok {
+		return x, nil
+	}
+	return Port(0), fmt.Errorf("%s is %w", name, ErrInvalidPort)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Port) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Port) UnmarshalText(text []byte) error {
+	tmp, err := ParsePortBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Port) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
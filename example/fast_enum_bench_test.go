@@ -0,0 +1,266 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import "testing"
+
+var _DeltaBenchNames = []string{
+	"min",
+	"lower",
+	"low",
+}
+
+var _DeltaBenchValues = []Delta{
+	DeltaMin,
+	DeltaLower,
+	DeltaLow,
+}
+
+func BenchmarkDeltaStringMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _DeltaMap[_DeltaBenchValues[i%len(_DeltaBenchValues)]]
+	}
+}
+
+func BenchmarkDeltaString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _DeltaBenchValues[i%len(_DeltaBenchValues)].String()
+	}
+}
+
+func BenchmarkDeltaParseMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _DeltaValue[_DeltaBenchNames[i%len(_DeltaBenchNames)]]
+	}
+}
+
+func BenchmarkDeltaParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseDelta(_DeltaBenchNames[i%len(_DeltaBenchNames)])
+	}
+}
+
+var _ElementBenchNames = []string{
+	"hydrogen",
+	"helium",
+	"lithium",
+	"beryllium",
+	"boron",
+	"carbon",
+	"nitrogen",
+	"oxygen",
+	"fluorine",
+	"neon",
+	"sodium",
+	"magnesium",
+	"aluminium",
+	"silicon",
+	"phosphorus",
+	"sulfur",
+	"chlorine",
+	"argon",
+	"potassium",
+	"calcium",
+	"scandium",
+	"titanium",
+	"vanadium",
+	"chromium",
+	"manganese",
+	"iron",
+	"cobalt",
+	"nickel",
+	"copper",
+	"zinc",
+	"Gallium",
+	"Germanium",
+	"Arsenic",
+	"Selenium",
+	"Bromine",
+	"Krypton",
+}
+
+var _ElementBenchValues = []Element{
+	ElementHydrogen,
+	ElementHelium,
+	ElementLithium,
+	ElementBeryllium,
+	ElementBoron,
+	ElementCarbon,
+	ElementNitrogen,
+	ElementOxygen,
+	ElementFluorine,
+	ElementNeon,
+	ElementSodium,
+	ElementMagnesium,
+	ElementAluminium,
+	ElementSilicon,
+	ElementPhosphorus,
+	ElementSulfur,
+	ElementChlorine,
+	ElementArgon,
+	ElementPotassium,
+	ElementCalcium,
+	ElementScandium,
+	ElementTitanium,
+	ElementVanadium,
+	ElementChromium,
+	ElementManganese,
+	ElementIron,
+	ElementCobalt,
+	ElementNickel,
+	ElementCopper,
+	ElementZinc,
+	ElementGallium,
+	ElementGermanium,
+	ElementArsenic,
+	ElementSelenium,
+	ElementBromine,
+	ElementKrypton,
+}
+
+func BenchmarkElementString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _ElementBenchValues[i%len(_ElementBenchValues)].String()
+	}
+}
+
+func BenchmarkElementParseMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _ElementValue[_ElementBenchNames[i%len(_ElementBenchNames)]]
+	}
+}
+
+func BenchmarkElementParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseElement(_ElementBenchNames[i%len(_ElementBenchNames)])
+	}
+}
+
+var _LogLevelBenchNames = []string{
+	"trace",
+	"debug",
+	"info",
+	"warn",
+	"error",
+}
+
+var _LogLevelBenchValues = []LogLevel{
+	LogLevelTrace,
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelWarn,
+	LogLevelError,
+}
+
+func BenchmarkLogLevelStringMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _LogLevelMap[_LogLevelBenchValues[i%len(_LogLevelBenchValues)]]
+	}
+}
+
+func BenchmarkLogLevelString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _LogLevelBenchValues[i%len(_LogLevelBenchValues)].String()
+	}
+}
+
+func BenchmarkLogLevelParseMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _LogLevelValue[_LogLevelBenchNames[i%len(_LogLevelBenchNames)]]
+	}
+}
+
+func BenchmarkLogLevelParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseLogLevel(_LogLevelBenchNames[i%len(_LogLevelBenchNames)])
+	}
+}
+
+var _OpcodeBenchNames = []string{
+	"nop",
+	"load",
+	"store",
+	"add",
+	"sub",
+	"mul",
+	"div",
+}
+
+var _OpcodeBenchValues = []Opcode{
+	OpcodeNop,
+	OpcodeLoad,
+	OpcodeStore,
+	OpcodeAdd,
+	OpcodeSub,
+	OpcodeMul,
+	OpcodeDiv,
+}
+
+func BenchmarkOpcodeStringMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _OpcodeMap[_OpcodeBenchValues[i%len(_OpcodeBenchValues)]]
+	}
+}
+
+func BenchmarkOpcodeString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _OpcodeBenchValues[i%len(_OpcodeBenchValues)].String()
+	}
+}
+
+func BenchmarkOpcodeParseMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _OpcodeValue[_OpcodeBenchNames[i%len(_OpcodeBenchNames)]]
+	}
+}
+
+func BenchmarkOpcodeParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseOpcode(_OpcodeBenchNames[i%len(_OpcodeBenchNames)])
+	}
+}
+
+var _PortBenchNames = []string{
+	"ssh",
+	"http",
+	"https",
+	"postgres",
+}
+
+var _PortBenchValues = []Port{
+	PortSsh,
+	PortHttp,
+	PortHttps,
+	PortPostgres,
+}
+
+func BenchmarkPortStringMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _PortMap[_PortBenchValues[i%len(_PortBenchValues)]]
+	}
+}
+
+func BenchmarkPortString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _PortBenchValues[i%len(_PortBenchValues)].String()
+	}
+}
+
+func BenchmarkPortParseMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = _PortValue[_PortBenchNames[i%len(_PortBenchNames)]]
+	}
+}
+
+func BenchmarkPortParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParsePort(_PortBenchNames[i%len(_PortBenchNames)])
+	}
+}
@@ -0,0 +1,88 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpcodeFast(t *testing.T) {
+	for _, x := range []Opcode{OpcodeNop, OpcodeLoad, OpcodeStore, OpcodeAdd, OpcodeSub, OpcodeMul, OpcodeDiv} {
+		assert.True(t, x.IsValid())
+		parsed, err := ParseOpcode(x.String())
+		require.NoError(t, err)
+		assert.Equal(t, x, parsed)
+	}
+	assert.Equal(t, "store", OpcodeStore.String())
+	assert.Equal(t, "add", OpcodeAdd.String())
+
+	// The skipped value and anything past the end are not valid
+	assert.False(t, Opcode(3).IsValid())
+	assert.Equal(t, "Opcode(3)", Opcode(3).String())
+	assert.Equal(t, "Opcode(200)", Opcode(200).String())
+
+	parsed, err := ParseOpcode("MUL")
+	require.NoError(t, err)
+	assert.Equal(t, OpcodeMul, parsed)
+
+	_, err = ParseOpcode("jmp")
+	assert.ErrorIs(t, err, ErrInvalidOpcode)
+}
+
+func TestLogLevelFast(t *testing.T) {
+	assert.Equal(t, LogLevel(-2), LogLevelTrace)
+	assert.Equal(t, "trace", LogLevelTrace.String())
+	assert.Equal(t, "error", LogLevelError.String())
+	assert.Equal(t, "LogLevel(-3)", LogLevel(-3).String())
+	assert.Equal(t, "LogLevel(3)", LogLevel(3).String())
+	assert.False(t, LogLevel(-3).IsValid())
+	assert.True(t, LogLevelInfo.IsValid())
+}
+
+func TestDeltaFast(t *testing.T) {
+	assert.Equal(t, Delta(-128), DeltaMin)
+	assert.Equal(t, "min", DeltaMin.String())
+	assert.Equal(t, "low", DeltaLow.String())
+	assert.True(t, DeltaLower.IsValid())
+	assert.False(t, Delta(-125).IsValid())
+	assert.False(t, Delta(127).IsValid())
+	assert.Equal(t, "Delta(127)", Delta(127).String())
+}
+
+func TestPortFast(t *testing.T) {
+	assert.Equal(t, "https", PortHttps.String())
+	assert.Equal(t, "Port(444)", Port(444).String())
+	assert.Equal(t, "Port(8080)", Port(8080).String())
+	assert.True(t, PortPostgres.IsValid())
+	assert.False(t, Port(0).IsValid())
+
+	parsed, err := ParsePort("Postgres")
+	require.NoError(t, err)
+	assert.Equal(t, PortPostgres, parsed)
+}
+
+func TestElementFast(t *testing.T) {
+	for _, name := range _ElementBenchNames {
+		x, err := ParseElement(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, name, x.String())
+		assert.True(t, x.IsValid())
+	}
+
+	x, err := ParseElement("KRYPTON")
+	require.NoError(t, err)
+	assert.Equal(t, ElementKrypton, x)
+
+	x, err = ParseElement("gallium")
+	require.NoError(t, err)
+	assert.Equal(t, ElementGallium, x)
+
+	_, err = ParseElement("unobtainium")
+	assert.ErrorIs(t, err, ErrInvalidElement)
+	_, err = ParseElement("")
+	assert.ErrorIs(t, err, ErrInvalidElement)
+}
@@ -57,3 +57,12 @@ func ParseForceLowerType(name string) (ForceLowerType, error) {
 	}
 	return ForceLowerType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceLowerType)
 }
+
+// ParseForceLowerTypeBytes attempts to convert a byte slice to a ForceLowerType, without allocating
+// when name is valid.
+func ParseForceLowerTypeBytes(name []byte) (ForceLowerType, error) {
+	if x, ok := _ForceLowerTypeValue[string(name)]; ok {
+		return x, nil
+	}
+	return ForceLowerType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceLowerType)
+}
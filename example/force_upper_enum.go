@@ -57,3 +57,12 @@ func ParseForceUpperType(name string) (ForceUpperType, error) {
 	}
 	return ForceUpperType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceUpperType)
 }
+
+// ParseForceUpperTypeBytes attempts to convert a byte slice to a ForceUpperType, without allocating
+// when name is valid.
+func ParseForceUpperTypeBytes(name []byte) (ForceUpperType, error) {
+	if x, ok := _ForceUpperTypeValue[string(name)]; ok {
+		return x, nil
+	}
+	return ForceUpperType(0), fmt.Errorf("%s is %w", name, ErrInvalidForceUpperType)
+}
@@ -153,3 +153,12 @@ func ParseLetter(name string) (Letter, error) {
 	}
 	return Letter(0), fmt.Errorf("%s is %w", name, ErrInvalidLetter)
 }
+
+// ParseLetterBytes attempts to convert a byte slice to a Letter, without allocating
+// when name is valid.
+func ParseLetterBytes(name []byte) (Letter, error) {
+	if x, ok := _LetterValue[string(name)]; ok {
+		return x, nil
+	}
+	return Letter(0), fmt.Errorf("%s is %w", name, ErrInvalidLetter)
+}
@@ -89,3 +89,12 @@ func ParseNumber(name string) (Number, error) {
 	}
 	return Number(0), fmt.Errorf("%s is %w", name, ErrInvalidNumber)
 }
+
+// ParseNumberBytes attempts to convert a byte slice to a Number, without allocating
+// when name is valid.
+func ParseNumberBytes(name []byte) (Number, error) {
+	if x, ok := _NumberValue[string(name)]; ok {
+		return x, nil
+	}
+	return Number(0), fmt.Errorf("%s is %w", name, ErrInvalidNumber)
+}
@@ -44,3 +44,12 @@ func ParseTestOnlyEnum(name string) (TestOnlyEnum, error) {
 	}
 	return TestOnlyEnum(""), fmt.Errorf("%s is %w", name, ErrInvalidTestOnlyEnum)
 }
+
+// ParseTestOnlyEnumBytes attempts to convert a byte slice to a TestOnlyEnum, without allocating
+// when name is valid.
+func ParseTestOnlyEnumBytes(name []byte) (TestOnlyEnum, error) {
+	if x, ok := _TestOnlyEnumValue[string(name)]; ok {
+		return x, nil
+	}
+	return TestOnlyEnum(""), fmt.Errorf("%s is %w", name, ErrInvalidTestOnlyEnum)
+}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --guard -b example
+
+package example
+
+// Quarter is an enumeration with a compile time guard.
+// ENUM(q1 = 1, q2, q3, q4)
+type Quarter int
+
+// Currency is a string enumeration with a compile time guard.
+// ENUM(euro = €, pound = £, yen = ¥)
+type Currency string
@@ -0,0 +1,146 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// CurrencyEuro is a Currency of type euro.
+	CurrencyEuro Currency = "€"
+	// CurrencyPound is a Currency of type pound.
+	CurrencyPound Currency = "£"
+	// CurrencyYen is a Currency of type yen.
+	CurrencyYen Currency = "¥"
+)
+
+var ErrInvalidCurrency = errors.New("not a valid Currency")
+
+// String implements the Stringer interface.
+func (x Currency) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Currency) IsValid() bool {
+	_, err := ParseCurrency(string(x))
+	return err == nil
+}
+
+var _CurrencyValue = map[string]Currency{
+	"€": CurrencyEuro,
+	"£": CurrencyPound,
+	"¥": CurrencyYen,
+}
+
+// ParseCurrency attempts to convert a string to a Currency.
+func ParseCurrency(name string) (Currency, error) {
+	if x, ok := _CurrencyValue[name]; ok {
+		return x, nil
+	}
+	return Currency(""), fmt.Errorf("%s is %w", name, ErrInvalidCurrency)
+}
+
+// ParseCurrencyBytes attempts to convert a byte slice to a Currency, without allocating
+// when name is valid.
+func ParseCurrencyBytes(name []byte) (Currency, error) {
+	if x, ok := _CurrencyValue[string(name)]; ok {
+		return x, nil
+	}
+	return Currency(""), fmt.Errorf("%s is %w", name, ErrInvalidCurrency)
+}
+
+// _CurrencyDeclHash is the hash of the ENUM declaration of Currency this code was generated from.
+const _CurrencyDeclHash = "26f4d9d325769f90"
+
+func _() {
+	// A "duplicate key false" compiler error signifies that the constant values have changed.
+	// Re-run go-enum to generate them again.
+	_ = map[bool]struct{}{false: {}, CurrencyEuro == "€": {}}
+	_ = map[bool]struct{}{false: {}, CurrencyPound == "£": {}}
+	_ = map[bool]struct{}{false: {}, CurrencyYen == "¥": {}}
+}
+
+const (
+	// QuarterQ1 is a Quarter of type Q1.
+	QuarterQ1 Quarter = iota + 1
+	// QuarterQ2 is a Quarter of type Q2.
+	QuarterQ2
+	// QuarterQ3 is a Quarter of type Q3.
+	QuarterQ3
+	// QuarterQ4 is a Quarter of type Q4.
+	QuarterQ4
+)
+
+var ErrInvalidQuarter = errors.New("not a valid Quarter")
+
+const _QuarterName = "q1q2q3q4"
+
+var _QuarterMap = map[Quarter]string{
+	QuarterQ1: _QuarterName[0:2],
+	QuarterQ2: _QuarterName[2:4],
+	QuarterQ3: _QuarterName[4:6],
+	QuarterQ4: _QuarterName[6:8],
+}
+
+// String implements the Stringer interface.
+func (x Quarter) String() string {
+	if str, ok := _QuarterMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Quarter(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Quarter) IsValid() bool {
+	_, ok := _QuarterMap[x]
+	return ok
+}
+
+var _QuarterValue = map[string]Quarter{
+	_QuarterName[0:2]: QuarterQ1,
+	_QuarterName[2:4]: QuarterQ2,
+	_QuarterName[4:6]: QuarterQ3,
+	_QuarterName[6:8]: QuarterQ4,
+}
+
+// ParseQuarter attempts to convert a string to a Quarter.
+func ParseQuarter(name string) (Quarter, error) {
+	if x, ok := _QuarterValue[name]; ok {
+		return x, nil
+	}
+	return Quarter(0), fmt.Errorf("%s is %w", name, ErrInvalidQuarter)
+}
+
+// ParseQuarterBytes attempts to convert a byte slice to a Quarter, without allocating
+// when name is valid.
+func ParseQuarterBytes(name []byte) (Quarter, error) {
+	if x, ok := _QuarterValue[string(name)]; ok {
+		return x, nil
+	}
+	return Quarter(0), fmt.Errorf("%s is %w", name, ErrInvalidQuarter)
+}
+
+// _QuarterDeclHash is the hash of the ENUM declaration of Quarter this code was generated from.
+const _QuarterDeclHash = "41698d970d90319e"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run go-enum to generate them again.
+	var x [1]struct{}
+	_ = x[QuarterQ1-1]
+	_ = x[QuarterQ2-2]
+	_ = x[QuarterQ3-3]
+	_ = x[QuarterQ4-4]
+}
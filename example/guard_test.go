@@ -0,0 +1,24 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/abice/go-enum/generator"
+)
+
+func TestGuardUpToDate(t *testing.T) {
+	stale, err := generator.NewGenerator().StaleEnumsFromFile("guard.go", "guard_enum.go")
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+}
+
+func TestCurrencyString(t *testing.T) {
+	assert.Equal(t, "¥", CurrencyYen.String())
+	assert.Equal(t, Quarter(3), QuarterQ3)
+}
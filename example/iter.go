@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --iter --names --values -b example
+
+package example
+
+// Planet is an enumeration with range over func iterators.
+// ENUM(mercury, venus, earth, _, mars)
+type Planet int
+
+// Suit is a string enumeration with range over func iterators.
+// ENUM(clubs, diamonds, hearts, spades)
+type Suit string
@@ -0,0 +1,246 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+const (
+	// PlanetMercury is a Planet of type Mercury.
+	PlanetMercury Planet = iota
+	// PlanetVenus is a Planet of type Venus.
+	PlanetVenus
+	// PlanetEarth is a Planet of type Earth.
+	PlanetEarth
+	// Skipped value.
+	_
+	// PlanetMars is a Planet of type Mars.
+	PlanetMars
+)
+
+var ErrInvalidPlanet = fmt.Errorf("not a valid Planet, try [%s]", strings.Join(_PlanetNames, ", "))
+
+const _PlanetName = "mercuryvenusearthmars"
+
+var _PlanetNames = []string{
+	_PlanetName[0:7],
+	_PlanetName[7:12],
+	_PlanetName[12:17],
+	_PlanetName[17:21],
+}
+
+// PlanetNames returns a list of possible string values of Planet.
+func PlanetNames() []string {
+	tmp := make([]string, len(_PlanetNames))
+	copy(tmp, _PlanetNames)
+	return tmp
+}
+
+// PlanetValues returns a list of the values for Planet
+func PlanetValues() []Planet {
+	return []Planet{
+		PlanetMercury,
+		PlanetVenus,
+		PlanetEarth,
+		PlanetMars,
+	}
+}
+
+var _PlanetMap = map[Planet]string{
+	PlanetMercury: _PlanetName[0:7],
+	PlanetVenus:   _PlanetName[7:12],
+	PlanetEarth:   _PlanetName[12:17],
+	PlanetMars:    _PlanetName[17:21],
+}
+
+// String implements the Stringer interface.
+func (x Planet) String() string {
+	if str, ok := _PlanetMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Planet(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Planet) IsValid() bool {
+	_, ok := _PlanetMap[x]
+	return ok
+}
+
+// PlanetAll returns an iterator over the declared Planet values, in declaration order.
+func PlanetAll() iter.Seq[Planet] {
+	return func(yield func(Planet) bool) {
+		for _, x := range [...]Planet{
+			PlanetMercury,
+			PlanetVenus,
+			PlanetEarth,
+			PlanetMars,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// PlanetEntries returns an iterator over the names and values of Planet, in declaration order.
+func PlanetEntries() iter.Seq2[string, Planet] {
+	return func(yield func(string, Planet) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value Planet
+		}{
+			{_PlanetName[0:7], PlanetMercury},
+			{_PlanetName[7:12], PlanetVenus},
+			{_PlanetName[12:17], PlanetEarth},
+			{_PlanetName[17:21], PlanetMars},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _PlanetValue = map[string]Planet{
+	_PlanetName[0:7]:   PlanetMercury,
+	_PlanetName[7:12]:  PlanetVenus,
+	_PlanetName[12:17]: PlanetEarth,
+	_PlanetName[17:21]: PlanetMars,
+}
+
+// ParsePlanet attempts to convert a string to a Planet.
+func ParsePlanet(name string) (Planet, error) {
+	if x, ok := _PlanetValue[name]; ok {
+		return x, nil
+	}
+	return Planet(0), fmt.Errorf("%s is %w", name, ErrInvalidPlanet)
+}
+
+// ParsePlanetBytes attempts to convert a byte slice to a Planet, without allocating
+// when name is valid.
+func ParsePlanetBytes(name []byte) (Planet, error) {
+	if x, ok := _PlanetValue[string(name)]; ok {
+		return x, nil
+	}
+	return Planet(0), fmt.Errorf("%s is %w", name, ErrInvalidPlanet)
+}
+
+const (
+	// SuitClubs is a Suit of type clubs.
+	SuitClubs Suit = "clubs"
+	// SuitDiamonds is a Suit of type diamonds.
+	SuitDiamonds Suit = "diamonds"
+	// SuitHearts is a Suit of type hearts.
+	SuitHearts Suit = "hearts"
+	// SuitSpades is a Suit of type spades.
+	SuitSpades Suit = "spades"
+)
+
+var ErrInvalidSuit = fmt.Errorf("not a valid Suit, try [%s]", strings.Join(_SuitNames, ", "))
+
+var _SuitNames = []string{
+	string(SuitClubs),
+	string(SuitDiamonds),
+	string(SuitHearts),
+	string(SuitSpades),
+}
+
+// SuitNames returns a list of possible string values of Suit.
+func SuitNames() []string {
+	tmp := make([]string, len(_SuitNames))
+	copy(tmp, _SuitNames)
+	return tmp
+}
+
+// SuitValues returns a list of the values for Suit
+func SuitValues() []Suit {
+	return []Suit{
+		SuitClubs,
+		SuitDiamonds,
+		SuitHearts,
+		SuitSpades,
+	}
+}
+
+// String implements the Stringer interface.
+func (x Suit) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Suit) IsValid() bool {
+	_, err := ParseSuit(string(x))
+	return err == nil
+}
+
+// SuitAll returns an iterator over the declared Suit values, in declaration order.
+func SuitAll() iter.Seq[Suit] {
+	return func(yield func(Suit) bool) {
+		for _, x := range [...]Suit{
+			SuitClubs,
+			SuitDiamonds,
+			SuitHearts,
+			SuitSpades,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// SuitEntries returns an iterator over the names and values of Suit, in declaration order.
+func SuitEntries() iter.Seq2[string, Suit] {
+	return func(yield func(string, Suit) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value Suit
+		}{
+			{"clubs", SuitClubs},
+			{"diamonds", SuitDiamonds},
+			{"hearts", SuitHearts},
+			{"spades", SuitSpades},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _SuitValue = map[string]Suit{
+	"clubs":    SuitClubs,
+	"diamonds": SuitDiamonds,
+	"hearts":   SuitHearts,
+	"spades":   SuitSpades,
+}
+
+// ParseSuit attempts to convert a string to a Suit.
+func ParseSuit(name string) (Suit, error) {
+	if x, ok := _SuitValue[name]; ok {
+		return x, nil
+	}
+	return Suit(""), fmt.Errorf("%s is %w", name, ErrInvalidSuit)
+}
+
+// ParseSuitBytes attempts to convert a byte slice to a Suit, without allocating
+// when name is valid.
+func ParseSuitBytes(name []byte) (Suit, error) {
+	if x, ok := _SuitValue[string(name)]; ok {
+		return x, nil
+	}
+	return Suit(""), fmt.Errorf("%s is %w", name, ErrInvalidSuit)
+}
@@ -0,0 +1,60 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanetAll(t *testing.T) {
+	var all []Planet
+	for x := range PlanetAll() {
+		all = append(all, x)
+	}
+	assert.Equal(t, PlanetValues(), all)
+
+	var names []string
+	for name, x := range PlanetEntries() {
+		names = append(names, name)
+		assert.Equal(t, x.String(), name)
+	}
+	assert.Equal(t, PlanetNames(), names)
+
+	// Stopping early must not panic
+	for x := range PlanetAll() {
+		if x == PlanetVenus {
+			break
+		}
+	}
+}
+
+func TestSuitEntries(t *testing.T) {
+	entries := map[string]Suit{}
+	for name, x := range SuitEntries() {
+		entries[name] = x
+	}
+	assert.Equal(t, map[string]Suit{
+		"clubs":    SuitClubs,
+		"diamonds": SuitDiamonds,
+		"hearts":   SuitHearts,
+		"spades":   SuitSpades,
+	}, entries)
+}
+
+func TestIteratorAllocations(t *testing.T) {
+	var count int
+	allocs := testing.AllocsPerRun(100, func() {
+		count = 0
+		for range SuitAll() {
+			count++
+		}
+		for range PlanetEntries() {
+			count++
+		}
+	})
+	assert.Equal(t, 8, count)
+	assert.Zero(t, allocs)
+}
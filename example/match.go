@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --match -b example
+
+package example
+
+// TrafficLight is an enumeration with Match functions.
+// ENUM(red, _, amber, green)
+type TrafficLight int
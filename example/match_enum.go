@@ -0,0 +1,112 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// TrafficLightRed is a TrafficLight of type Red.
+	TrafficLightRed TrafficLight = iota
+	// Skipped value.
+	_
+	// TrafficLightAmber is a TrafficLight of type Amber.
+	TrafficLightAmber
+	// TrafficLightGreen is a TrafficLight of type Green.
+	TrafficLightGreen
+)
+
+var ErrInvalidTrafficLight = errors.New("not a valid TrafficLight")
+
+const _TrafficLightName = "redambergreen"
+
+var _TrafficLightMap = map[TrafficLight]string{
+	TrafficLightRed:   _TrafficLightName[0:3],
+	TrafficLightAmber: _TrafficLightName[3:8],
+	TrafficLightGreen: _TrafficLightName[8:13],
+}
+
+// String implements the Stringer interface.
+func (x TrafficLight) String() string {
+	if str, ok := _TrafficLightMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TrafficLight(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TrafficLight) IsValid() bool {
+	_, ok := _TrafficLightMap[x]
+	return ok
+}
+
+var _TrafficLightValue = map[string]TrafficLight{
+	_TrafficLightName[0:3]:  TrafficLightRed,
+	_TrafficLightName[3:8]:  TrafficLightAmber,
+	_TrafficLightName[8:13]: TrafficLightGreen,
+}
+
+// ParseTrafficLight attempts to convert a string to a TrafficLight.
+func ParseTrafficLight(name string) (TrafficLight, error) {
+	if x, ok := _TrafficLightValue[name]; ok {
+		return x, nil
+	}
+	return TrafficLight(0), fmt.Errorf("%s is %w", name, ErrInvalidTrafficLight)
+}
+
+// ParseTrafficLightBytes attempts to convert a byte slice to a TrafficLight, without allocating
+// when name is valid.
+func ParseTrafficLightBytes(name []byte) (TrafficLight, error) {
+	if x, ok := _TrafficLightValue[string(name)]; ok {
+		return x, nil
+	}
+	return TrafficLight(0), fmt.Errorf("%s is %w", name, ErrInvalidTrafficLight)
+}
+
+// Match calls the function for the value of x, and nothing if x is not declared.  It takes a
+// function for every declared value, so adding a value breaks every call until it is handled.
+func (x TrafficLight) Match(
+	onRed func(),
+	onAmber func(),
+	onGreen func(),
+) {
+	switch x {
+	case TrafficLightRed:
+		onRed()
+	case TrafficLightAmber:
+		onAmber()
+	case TrafficLightGreen:
+		onGreen()
+	}
+}
+
+// MatchTrafficLight returns the result of the function for the value of x, or the zero value
+// of T if x is not declared.  It takes a function for every declared value, so adding a value
+// breaks every call until it is handled.
+func MatchTrafficLight[T any](
+	x TrafficLight,
+	onRed func() T,
+	onAmber func() T,
+	onGreen func() T,
+) T {
+	switch x {
+	case TrafficLightRed:
+		return onRed()
+	case TrafficLightAmber:
+		return onAmber()
+	case TrafficLightGreen:
+		return onGreen()
+	}
+	var zero T
+	return zero
+}
@@ -0,0 +1,36 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficLightMatch(t *testing.T) {
+	var got []string
+	for _, x := range []TrafficLight{TrafficLightGreen, TrafficLightRed, TrafficLight(1)} {
+		x.Match(
+			func() { got = append(got, "stop") },
+			func() { got = append(got, "slow") },
+			func() { got = append(got, "go") },
+		)
+	}
+	assert.Equal(t, []string{"go", "stop"}, got)
+}
+
+func TestMatchTrafficLight(t *testing.T) {
+	wait := func(x TrafficLight) int {
+		return MatchTrafficLight(x,
+			func() int { return 30 },
+			func() int { return 3 },
+			func() int { return 0 },
+		)
+	}
+	assert.Equal(t, 30, wait(TrafficLightRed))
+	assert.Equal(t, 3, wait(TrafficLightAmber))
+	assert.Equal(t, 0, wait(TrafficLightGreen))
+	assert.Equal(t, 0, wait(TrafficLight(1)))
+}
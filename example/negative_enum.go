@@ -53,14 +53,34 @@ func (x AllNegative) IsValid() bool {
 }
 
 var _AllNegativeValue = map[string]AllNegative{
-	_AllNegativeName[0:7]:                    AllNegativeUnknown,
-	strings.ToLower(_AllNegativeName[0:7]):   AllNegativeUnknown,
-	_AllNegativeName[7:11]:                   AllNegativeGood,
-	strings.ToLower(_AllNegativeName[7:11]):  AllNegativeGood,
-	_AllNegativeName[11:14]:                  AllNegativeBad,
-	strings.ToLower(_AllNegativeName[11:14]): AllNegativeBad,
-	_AllNegativeName[14:18]:                  AllNegativeUgly,
-	strings.ToLower(_AllNegativeName[14:18]): AllNegativeUgly,
+	_AllNegativeName[0:7]:   AllNegativeUnknown,
+	_AllNegativeName[7:11]:  AllNegativeGood,
+	_AllNegativeName[11:14]: AllNegativeBad,
+	_AllNegativeName[14:18]: AllNegativeUgly,
+}
+
+// foldAllNegative finds the AllNegative for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldAllNegative(name string) (AllNegative, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "Bad") {
+			return AllNegativeBad, true
+		}
+	case 4:
+		if strings.EqualFold(name, "Good") {
+			return AllNegativeGood, true
+		}
+		if strings.EqualFold(name, "Ugly") {
+			return AllNegativeUgly, true
+		}
+	case 7:
+		if strings.EqualFold(name, "Unknown") {
+			return AllNegativeUnknown, true
+		}
+	}
+	return 0, false
 }
 
 // ParseAllNegative attempts to convert a string to a AllNegative.
@@ -68,8 +88,20 @@ func ParseAllNegative(name string) (AllNegative, error) {
 	if x, ok := _AllNegativeValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _AllNegativeValue[strings.ToLower(name)]; ok {
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldAllNegative(name); ok {
+		return x, nil
+	}
+	return AllNegative(0), fmt.Errorf("%s is %w", name, ErrInvalidAllNegative)
+}
+
+// ParseAllNegativeBytes attempts to convert a byte slice to a AllNegative, without allocating
+// when name is valid.
+func ParseAllNegativeBytes(name []byte) (AllNegative, error) {
+	if x, ok := _AllNegativeValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := foldAllNegative(string(name)); ok {
 		return x, nil
 	}
 	return AllNegative(0), fmt.Errorf("%s is %w", name, ErrInvalidAllNegative)
@@ -110,12 +142,30 @@ func (x Status) IsValid() bool {
 }
 
 var _StatusValue = map[string]Status{
-	_StatusName[0:7]:                    StatusUnknown,
-	strings.ToLower(_StatusName[0:7]):   StatusUnknown,
-	_StatusName[7:11]:                   StatusGood,
-	strings.ToLower(_StatusName[7:11]):  StatusGood,
-	_StatusName[11:14]:                  StatusBad,
-	strings.ToLower(_StatusName[11:14]): StatusBad,
+	_StatusName[0:7]:   StatusUnknown,
+	_StatusName[7:11]:  StatusGood,
+	_StatusName[11:14]: StatusBad,
+}
+
+// foldStatus finds the Status for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldStatus(name string) (Status, bool) {
+	switch len(name) {
+	case 3:
+		if strings.EqualFold(name, "Bad") {
+			return StatusBad, true
+		}
+	case 4:
+		if strings.EqualFold(name, "Good") {
+			return StatusGood, true
+		}
+	case 7:
+		if strings.EqualFold(name, "Unknown") {
+			return StatusUnknown, true
+		}
+	}
+	return 0, false
 }
 
 // ParseStatus attempts to convert a string to a Status.
@@ -123,8 +173,20 @@ func ParseStatus(name string) (Status, error) {
 	if x, ok := _StatusValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _StatusValue[strings.ToLower(name)]; ok {
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldStatus(name); ok {
+		return x, nil
+	}
+	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
+}
+
+// ParseStatusBytes attempts to convert a byte slice to a Status, without allocating
+// when name is valid.
+func ParseStatusBytes(name []byte) (Status, error) {
+	if x, ok := _StatusValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := foldStatus(string(name)); ok {
 		return x, nil
 	}
 	return Status(0), fmt.Errorf("%s is %w", name, ErrInvalidStatus)
@@ -58,6 +58,15 @@ func parseUnparsedSqlString(name string) (UnparsedSqlString, error) {
 	return UnparsedSqlString(""), fmt.Errorf("%s is %w", name, ErrInvalidUnparsedSqlString)
 }
 
+// parseUnparsedSqlStringBytes attempts to convert a byte slice to a UnparsedSqlString, without allocating
+// when name is valid.
+func parseUnparsedSqlStringBytes(name []byte) (UnparsedSqlString, error) {
+	if x, ok := _UnparsedSqlStringValue[string(name)]; ok {
+		return x, nil
+	}
+	return UnparsedSqlString(""), fmt.Errorf("%s is %w", name, ErrInvalidUnparsedSqlString)
+}
+
 var errUnparsedSqlStringNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 // Scan implements the Scanner interface.
@@ -73,7 +82,7 @@ func (x *UnparsedSqlString) Scan(value interface{}) (err error) {
 	case string:
 		*x, err = parseUnparsedSqlString(v)
 	case []byte:
-		*x, err = parseUnparsedSqlString(string(v))
+		*x, err = parseUnparsedSqlStringBytes(v)
 	case UnparsedSqlString:
 		*x = v
 	case *UnparsedSqlString:
@@ -154,6 +163,15 @@ func parseUnparsedSqlValues(name string) (UnparsedSqlValues, error) {
 	return UnparsedSqlValues(0), fmt.Errorf("%s is %w", name, ErrInvalidUnparsedSqlValues)
 }
 
+// parseUnparsedSqlValuesBytes attempts to convert a byte slice to a UnparsedSqlValues, without allocating
+// when name is valid.
+func parseUnparsedSqlValuesBytes(name []byte) (UnparsedSqlValues, error) {
+	if x, ok := _UnparsedSqlValuesValue[string(name)]; ok {
+		return x, nil
+	}
+	return UnparsedSqlValues(0), fmt.Errorf("%s is %w", name, ErrInvalidUnparsedSqlValues)
+}
+
 var errUnparsedSqlValuesNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 // Scan implements the Scanner interface.
@@ -171,7 +189,7 @@ func (x *UnparsedSqlValues) Scan(value interface{}) (err error) {
 	case string:
 		*x, err = parseUnparsedSqlValues(v)
 	case []byte:
-		*x, err = parseUnparsedSqlValues(string(v))
+		*x, err = parseUnparsedSqlValuesBytes(v)
 	case UnparsedSqlValues:
 		*x = v
 	case int:
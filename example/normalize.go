@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --marshal --normalize -b example
+
+package example
+
+// TaskStatus is an enumeration that parses regardless of case and separators.
+// ENUM(pending, in-progress, on_hold, done)
+type TaskStatus int
@@ -0,0 +1,136 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// TaskStatusPending is a TaskStatus of type Pending.
+	TaskStatusPending TaskStatus = iota
+	// TaskStatusInProgress is a TaskStatus of type In-Progress.
+	TaskStatusInProgress
+	// TaskStatusOnHold is a TaskStatus of type On_hold.
+	TaskStatusOnHold
+	// TaskStatusDone is a TaskStatus of type Done.
+	TaskStatusDone
+)
+
+var ErrInvalidTaskStatus = errors.New("not a valid TaskStatus")
+
+const _TaskStatusName = "pendingin-progresson_holddone"
+
+var _TaskStatusMap = map[TaskStatus]string{
+	TaskStatusPending:    _TaskStatusName[0:7],
+	TaskStatusInProgress: _TaskStatusName[7:18],
+	TaskStatusOnHold:     _TaskStatusName[18:25],
+	TaskStatusDone:       _TaskStatusName[25:29],
+}
+
+// String implements the Stringer interface.
+func (x TaskStatus) String() string {
+	if str, ok := _TaskStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("TaskStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x TaskStatus) IsValid() bool {
+	_, ok := _TaskStatusMap[x]
+	return ok
+}
+
+var _TaskStatusValue = map[string]TaskStatus{
+	_TaskStatusName[0:7]:   TaskStatusPending,
+	_TaskStatusName[7:18]:  TaskStatusInProgress,
+	_TaskStatusName[18:25]: TaskStatusOnHold,
+	_TaskStatusName[25:29]: TaskStatusDone,
+}
+
+// _TaskStatusNormalized maps the lowercase names, without separators, to their values.
+var _TaskStatusNormalized = map[string]TaskStatus{
+	"pending":    TaskStatusPending,
+	"inprogress": TaskStatusInProgress,
+	"onhold":     TaskStatusOnHold,
+	"done":       TaskStatusDone,
+}
+
+// normalizedTaskStatus finds the TaskStatus for name ignoring ASCII case and the
+// -, _, . and space separators, without allocating.
+func normalizedTaskStatus(name string) (TaskStatus, bool) {
+	var buf [10]byte
+	n := 0
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '-' || c == '_' || c == '.' || c == ' ':
+			continue
+		case 'A' <= c && c <= 'Z':
+			c += 'a' - 'A'
+		}
+		if n == len(buf) {
+			return 0, false
+		}
+		buf[n] = c
+		n++
+	}
+	x, ok := _TaskStatusNormalized[string(buf[:n])]
+	return x, ok
+}
+
+// ParseTaskStatus attempts to convert a string to a TaskStatus.
+func ParseTaskStatus(name string) (TaskStatus, error) {
+	if x, ok := _TaskStatusValue[name]; ok {
+		return x, nil
+	}
+	if x, ok := normalizedTaskStatus(name); ok {
+		return x, nil
+	}
+	return TaskStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidTaskStatus)
+}
+
+// ParseTaskStatusBytes attempts to convert a byte slice to a TaskStatus, without allocating
+// when name is valid.
+func ParseTaskStatusBytes(name []byte) (TaskStatus, error) {
+	if x, ok := _TaskStatusValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := normalizedTaskStatus(string(name)); ok {
+		return x, nil
+	}
+	return TaskStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidTaskStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x TaskStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *TaskStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseTaskStatusBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *TaskStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,54 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskStatusNormalizedParse(t *testing.T) {
+	for _, input := range []string{"in-progress", "in_progress", "In Progress", "INPROGRESS", "in.progress"} {
+		x, err := ParseTaskStatus(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, TaskStatusInProgress, x, input)
+	}
+
+	_, err := ParseTaskStatus("in-progress-ish")
+	assert.ErrorIs(t, err, ErrInvalidTaskStatus)
+
+	var x struct {
+		Status TaskStatus `json:"status"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"status":"On Hold"}`), &x))
+	assert.Equal(t, TaskStatusOnHold, x.Status)
+
+	raw, err := json.Marshal(x)
+	require.NoError(t, err)
+	assert.Equal(t, `{"status":"on_hold"}`, string(raw))
+}
+
+func TestTaskStatusNormalizedAllocations(t *testing.T) {
+	name := "On-Hold"
+	var x TaskStatus
+	allocs := testing.AllocsPerRun(100, func() {
+		x, _ = ParseTaskStatus(name)
+	})
+	assert.Equal(t, TaskStatusOnHold, x)
+	assert.Zero(t, allocs)
+}
+
+func TestRegionCustomNormalizer(t *testing.T) {
+	for _, input := range []string{"us-east", "US East", "us–east", "USEAST"} {
+		x, err := ParseRegion(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, RegionUsEast, x, input)
+	}
+
+	_, err := ParseRegion("us-north")
+	assert.ErrorIs(t, err, ErrInvalidRegion)
+}
@@ -0,0 +1,22 @@
+//go:generate ../bin/go-enum --marshal --normalizer regionKey -b example
+
+package example
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Region is an enumeration that parses with a custom normalizer.
+// ENUM(us-east, us-west, eu-central)
+type Region string
+
+// regionKey drops everything but letters and digits, including unicode dashes and spaces.
+func regionKey(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,103 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// RegionUsEast is a Region of type us-east.
+	RegionUsEast Region = "us-east"
+	// RegionUsWest is a Region of type us-west.
+	RegionUsWest Region = "us-west"
+	// RegionEuCentral is a Region of type eu-central.
+	RegionEuCentral Region = "eu-central"
+)
+
+var ErrInvalidRegion = errors.New("not a valid Region")
+
+// String implements the Stringer interface.
+func (x Region) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Region) IsValid() bool {
+	_, err := ParseRegion(string(x))
+	return err == nil
+}
+
+var _RegionValue = map[string]Region{
+	"us-east":    RegionUsEast,
+	"us-west":    RegionUsWest,
+	"eu-central": RegionEuCentral,
+}
+
+// _RegionNormalized maps the names, passed through regionKey, to their values.
+var _RegionNormalized = map[string]Region{
+	regionKey("us-east"):    RegionUsEast,
+	regionKey("us-west"):    RegionUsWest,
+	regionKey("eu-central"): RegionEuCentral,
+}
+
+// normalizedRegion finds the Region for name after passing it through regionKey.
+func normalizedRegion(name string) (Region, bool) {
+	x, ok := _RegionNormalized[regionKey(name)]
+	return x, ok
+}
+
+// ParseRegion attempts to convert a string to a Region.
+func ParseRegion(name string) (Region, error) {
+	if x, ok := _RegionValue[name]; ok {
+		return x, nil
+	}
+	if x, ok := normalizedRegion(name); ok {
+		return x, nil
+	}
+	return Region(""), fmt.Errorf("%s is %w", name, ErrInvalidRegion)
+}
+
+// ParseRegionBytes attempts to convert a byte slice to a Region, without allocating
+// when name is valid.
+func ParseRegionBytes(name []byte) (Region, error) {
+	if x, ok := _RegionValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := normalizedRegion(string(name)); ok {
+		return x, nil
+	}
+	return Region(""), fmt.Errorf("%s is %w", name, ErrInvalidRegion)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Region) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Region) UnmarshalText(text []byte) error {
+	tmp, err := ParseRegionBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Region) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
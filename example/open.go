@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --marshal --sql --open -b example
+
+package example
+
+// ShipmentState is an enumeration that tolerates values added by newer producers.
+// ENUM(queued, in_transit, delivered)
+type ShipmentState int
+
+// ShipmentEvent is a string enumeration that tolerates values added by newer producers.
+// ENUM(created, updated, cancelled)
+type ShipmentEvent string
@@ -0,0 +1,324 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// ShipmentEventCreated is a ShipmentEvent of type created.
+	ShipmentEventCreated ShipmentEvent = "created"
+	// ShipmentEventUpdated is a ShipmentEvent of type updated.
+	ShipmentEventUpdated ShipmentEvent = "updated"
+	// ShipmentEventCancelled is a ShipmentEvent of type cancelled.
+	ShipmentEventCancelled ShipmentEvent = "cancelled"
+)
+
+var ErrInvalidShipmentEvent = errors.New("not a valid ShipmentEvent")
+
+// String implements the Stringer interface.
+func (x ShipmentEvent) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ShipmentEvent) IsValid() bool {
+	_, err := ParseShipmentEvent(string(x))
+	return err == nil
+}
+
+// IsKnown reports whether x is one of the declared ShipmentEvent values.
+// Unknown values are preserved when decoding, so this can be used to detect
+// values added by a newer producer.
+func (x ShipmentEvent) IsKnown() bool {
+	return x.IsValid()
+}
+
+var _ShipmentEventValue = map[string]ShipmentEvent{
+	"created":   ShipmentEventCreated,
+	"updated":   ShipmentEventUpdated,
+	"cancelled": ShipmentEventCancelled,
+}
+
+// ParseShipmentEvent attempts to convert a string to a ShipmentEvent.
+func ParseShipmentEvent(name string) (ShipmentEvent, error) {
+	if x, ok := _ShipmentEventValue[name]; ok {
+		return x, nil
+	}
+	return ShipmentEvent(""), fmt.Errorf("%s is %w", name, ErrInvalidShipmentEvent)
+}
+
+// ParseShipmentEventBytes attempts to convert a byte slice to a ShipmentEvent, without allocating
+// when name is valid.
+func ParseShipmentEventBytes(name []byte) (ShipmentEvent, error) {
+	if x, ok := _ShipmentEventValue[string(name)]; ok {
+		return x, nil
+	}
+	return ShipmentEvent(""), fmt.Errorf("%s is %w", name, ErrInvalidShipmentEvent)
+}
+
+// parseOpenShipmentEvent attempts to convert a string to a ShipmentEvent, keeping
+// the raw value when it is not declared so it survives a round trip.
+func parseOpenShipmentEvent(name string) (ShipmentEvent, error) {
+	if x, err := ParseShipmentEvent(name); err == nil {
+		return x, nil
+	}
+	return ShipmentEvent(name), nil
+}
+
+// MarshalText implements the text marshaller method.
+func (x ShipmentEvent) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ShipmentEvent) UnmarshalText(text []byte) error {
+	tmp, err := parseOpenShipmentEvent(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ShipmentEvent) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errShipmentEventNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ShipmentEvent) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ShipmentEvent("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = parseOpenShipmentEvent(v)
+	case []byte:
+		*x, err = parseOpenShipmentEvent(string(v))
+	case ShipmentEvent:
+		*x = v
+	case *ShipmentEvent:
+		if v == nil {
+			return errShipmentEventNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errShipmentEventNilPtr
+		}
+		*x, err = parseOpenShipmentEvent(*v)
+	default:
+		return errors.New("invalid type for ShipmentEvent")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ShipmentEvent) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+const (
+	// ShipmentStateQueued is a ShipmentState of type Queued.
+	ShipmentStateQueued ShipmentState = iota
+	// ShipmentStateInTransit is a ShipmentState of type In_transit.
+	ShipmentStateInTransit
+	// ShipmentStateDelivered is a ShipmentState of type Delivered.
+	ShipmentStateDelivered
+)
+
+var ErrInvalidShipmentState = errors.New("not a valid ShipmentState")
+
+const _ShipmentStateName = "queuedin_transitdelivered"
+
+var _ShipmentStateMap = map[ShipmentState]string{
+	ShipmentStateQueued:    _ShipmentStateName[0:6],
+	ShipmentStateInTransit: _ShipmentStateName[6:16],
+	ShipmentStateDelivered: _ShipmentStateName[16:25],
+}
+
+// String implements the Stringer interface.
+func (x ShipmentState) String() string {
+	if str, ok := _ShipmentStateMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("ShipmentState(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ShipmentState) IsValid() bool {
+	_, ok := _ShipmentStateMap[x]
+	return ok
+}
+
+// IsKnown reports whether x is one of the declared ShipmentState values.
+// Unknown values are preserved when decoding, so this can be used to detect
+// values added by a newer producer.
+func (x ShipmentState) IsKnown() bool {
+	return x.IsValid()
+}
+
+var _ShipmentStateValue = map[string]ShipmentState{
+	_ShipmentStateName[0:6]:   ShipmentStateQueued,
+	_ShipmentStateName[6:16]:  ShipmentStateInTransit,
+	_ShipmentStateName[16:25]: ShipmentStateDelivered,
+}
+
+// ParseShipmentState attempts to convert a string to a ShipmentState.
+func ParseShipmentState(name string) (ShipmentState, error) {
+	if x, ok := _ShipmentStateValue[name]; ok {
+		return x, nil
+	}
+	return ShipmentState(0), fmt.Errorf("%s is %w", name, ErrInvalidShipmentState)
+}
+
+// ParseShipmentStateBytes attempts to convert a byte slice to a ShipmentState, without allocating
+// when name is valid.
+func ParseShipmentStateBytes(name []byte) (ShipmentState, error) {
+	if x, ok := _ShipmentStateValue[string(name)]; ok {
+		return x, nil
+	}
+	return ShipmentState(0), fmt.Errorf("%s is %w", name, ErrInvalidShipmentState)
+}
+
+// parseOpenShipmentState attempts to convert a string to a ShipmentState, keeping
+// numeric values that are not declared so they survive a round trip.
+func parseOpenShipmentState(name string) (ShipmentState, error) {
+	x, err := ParseShipmentState(name)
+	if err != nil {
+		if val, verr := strconv.ParseInt(name, 10, strconv.IntSize); verr == nil {
+			// The number has to fit the type, or it would wrap around to another value
+			return ShipmentState(val), nil
+		}
+	}
+	return x, err
+}
+
+// MarshalText implements the text marshaller method.
+func (x ShipmentState) MarshalText() ([]byte, error) {
+	if !x.IsValid() {
+		// Unknown values are written as their number so they round trip.
+		return strconv.AppendInt(nil, int64(x), 10), nil
+	}
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ShipmentState) UnmarshalText(text []byte) error {
+	tmp, err := parseOpenShipmentState(string(text))
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ShipmentState) AppendText(b []byte) ([]byte, error) {
+	text, err := x.MarshalText()
+	return append(b, text...), err
+}
+
+var errShipmentStateNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *ShipmentState) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = ShipmentState(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = ShipmentState(v)
+	case string:
+		*x, err = parseOpenShipmentState(v)
+	case []byte:
+		*x, err = parseOpenShipmentState(string(v))
+	case ShipmentState:
+		*x = v
+	case int:
+		*x = ShipmentState(v)
+	case *ShipmentState:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = ShipmentState(v)
+	case uint64:
+		*x = ShipmentState(v)
+	case *int:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = ShipmentState(*v)
+	case *int64:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = ShipmentState(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = ShipmentState(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = ShipmentState(*v)
+	case *uint:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = ShipmentState(*v)
+	case *uint64:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x = ShipmentState(*v)
+	case *string:
+		if v == nil {
+			return errShipmentStateNilPtr
+		}
+		*x, err = parseOpenShipmentState(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x ShipmentState) Value() (driver.Value, error) {
+	if !x.IsValid() {
+		// Unknown values are stored as their number so they round trip.
+		return strconv.FormatInt(int64(x), 10), nil
+	}
+	return x.String(), nil
+}
@@ -0,0 +1,113 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShipmentStateOpenUnmarshal(t *testing.T) {
+	type testData struct {
+		State ShipmentState `json:"state"`
+	}
+	tests := []struct {
+		name     string
+		input    string
+		output   ShipmentState
+		known    bool
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "known",
+			input:    `{"state":"in_transit"}`,
+			output:   ShipmentStateInTransit,
+			known:    true,
+			expected: `{"state":"in_transit"}`,
+		},
+		{
+			name:     "unknown number",
+			input:    `{"state":"17"}`,
+			output:   ShipmentState(17),
+			known:    false,
+			expected: `{"state":"17"}`,
+		},
+		{
+			name:    "unknown name",
+			input:   `{"state":"returned"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var x testData
+			err := json.Unmarshal([]byte(tc.input), &x)
+			if tc.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidShipmentState)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.output, x.State)
+			assert.Equal(t, tc.known, x.State.IsKnown())
+			assert.Equal(t, tc.known, x.State.IsValid())
+
+			raw, err := json.Marshal(x)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(raw))
+		})
+	}
+}
+
+func TestShipmentStateOpenSQL(t *testing.T) {
+	x := ShipmentState(42)
+	val, err := x.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "42", val)
+
+	var y ShipmentState
+	require.NoError(t, y.Scan(val))
+	assert.Equal(t, x, y)
+	assert.False(t, y.IsKnown())
+
+	require.NoError(t, y.Scan("delivered"))
+	assert.Equal(t, ShipmentStateDelivered, y)
+}
+
+func TestShipmentEventOpenUnmarshal(t *testing.T) {
+	type testData struct {
+		Event ShipmentEvent `json:"event"`
+	}
+
+	var x testData
+	require.NoError(t, json.Unmarshal([]byte(`{"event":"rerouted"}`), &x))
+	assert.Equal(t, ShipmentEvent("rerouted"), x.Event)
+	assert.False(t, x.Event.IsKnown())
+
+	raw, err := json.Marshal(x)
+	require.NoError(t, err)
+	assert.Equal(t, `{"event":"rerouted"}`, string(raw))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"event":"updated"}`), &x))
+	assert.Equal(t, ShipmentEventUpdated, x.Event)
+	assert.True(t, x.Event.IsKnown())
+}
+
+func TestShipmentEventOpenSQL(t *testing.T) {
+	var x ShipmentEvent
+	require.NoError(t, x.Scan([]byte("archived")))
+	assert.Equal(t, ShipmentEvent("archived"), x)
+
+	val, err := x.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "archived", val)
+
+	_, err = ParseShipmentEvent("archived")
+	assert.ErrorIs(t, err, ErrInvalidShipmentEvent)
+}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --marshal --ordinal -b example
+
+package example
+
+// Severity is an enumeration that is ordered by declaration, with gaps in its values.
+// ENUM(debug=10, info=20, _, warn=40, error=50)
+type Severity int
+
+// Stage is a string enumeration that is ordered by declaration.
+// ENUM(draft, review, published, archived)
+type Stage string
@@ -0,0 +1,323 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// SeverityDebug is a Severity of type Debug.
+	SeverityDebug Severity = iota + 10
+	// SeverityInfo is a Severity of type Info.
+	SeverityInfo Severity = iota + 19
+	// Skipped value.
+	_
+	// SeverityWarn is a Severity of type Warn.
+	SeverityWarn Severity = iota + 37
+	// SeverityError is a Severity of type Error.
+	SeverityError Severity = iota + 46
+)
+
+var ErrInvalidSeverity = errors.New("not a valid Severity")
+
+const _SeverityName = "debuginfowarnerror"
+
+var _SeverityMap = map[Severity]string{
+	SeverityDebug: _SeverityName[0:5],
+	SeverityInfo:  _SeverityName[5:9],
+	SeverityWarn:  _SeverityName[9:13],
+	SeverityError: _SeverityName[13:18],
+}
+
+// String implements the Stringer interface.
+func (x Severity) String() string {
+	if str, ok := _SeverityMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Severity(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Severity) IsValid() bool {
+	_, ok := _SeverityMap[x]
+	return ok
+}
+
+const (
+	// SeverityMin is the first declared Severity.
+	SeverityMin = SeverityDebug
+	// SeverityMax is the last declared Severity.
+	SeverityMax = SeverityError
+	// SeverityCount is the number of declared Severity values.
+	SeverityCount = 4
+)
+
+var _SeverityOrdered = [SeverityCount]Severity{
+	SeverityDebug,
+	SeverityInfo,
+	SeverityWarn,
+	SeverityError,
+}
+
+// Ordinal returns the position of x in the declaration order of Severity, not counting
+// skipped values, or -1 if x is not a declared value.
+func (x Severity) Ordinal() int {
+	switch x {
+	case SeverityDebug:
+		return 0
+	case SeverityInfo:
+		return 1
+	case SeverityWarn:
+		return 2
+	case SeverityError:
+		return 3
+	}
+	return -1
+}
+
+// Next returns the Severity declared after x.
+// It returns x and false if x is not a declared value or is SeverityMax.
+func (x Severity) Next() (Severity, bool) {
+	i := x.Ordinal()
+	if i < 0 || i == SeverityCount-1 {
+		return x, false
+	}
+	return _SeverityOrdered[i+1], true
+}
+
+// Prev returns the Severity declared before x.
+// It returns x and false if x is not a declared value or is SeverityMin.
+func (x Severity) Prev() (Severity, bool) {
+	i := x.Ordinal()
+	if i < 1 {
+		return x, false
+	}
+	return _SeverityOrdered[i-1], true
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at the same
+// position as, or after other.  Values that are not declared sort before the declared
+// ones, and by their own value when neither is declared.
+func (x Severity) Compare(other Severity) int {
+	a, b := x.Ordinal(), other.Ordinal()
+	if a < 0 && b < 0 {
+		switch {
+		case x < other:
+			return -1
+		case x > other:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+var _SeverityValue = map[string]Severity{
+	_SeverityName[0:5]:   SeverityDebug,
+	_SeverityName[5:9]:   SeverityInfo,
+	_SeverityName[9:13]:  SeverityWarn,
+	_SeverityName[13:18]: SeverityError,
+}
+
+// ParseSeverity attempts to convert a string to a Severity.
+func ParseSeverity(name string) (Severity, error) {
+	if x, ok := _SeverityValue[name]; ok {
+		return x, nil
+	}
+	return Severity(0), fmt.Errorf("%s is %w", name, ErrInvalidSeverity)
+}
+
+// ParseSeverityBytes attempts to convert a byte slice to a Severity, without allocating
+// when name is valid.
+func ParseSeverityBytes(name []byte) (Severity, error) {
+	if x, ok := _SeverityValue[string(name)]; ok {
+		return x, nil
+	}
+	return Severity(0), fmt.Errorf("%s is %w", name, ErrInvalidSeverity)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Severity) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Severity) UnmarshalText(text []byte) error {
+	tmp, err := ParseSeverityBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Severity) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// StageDraft is a Stage of type draft.
+	StageDraft Stage = "draft"
+	// StageReview is a Stage of type review.
+	StageReview Stage = "review"
+	// StagePublished is a Stage of type published.
+	StagePublished Stage = "published"
+	// StageArchived is a Stage of type archived.
+	StageArchived Stage = "archived"
+)
+
+var ErrInvalidStage = errors.New("not a valid Stage")
+
+// String implements the Stringer interface.
+func (x Stage) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Stage) IsValid() bool {
+	_, err := ParseStage(string(x))
+	return err == nil
+}
+
+const (
+	// StageMin is the first declared Stage.
+	StageMin = StageDraft
+	// StageMax is the last declared Stage.
+	StageMax = StageArchived
+	// StageCount is the number of declared Stage values.
+	StageCount = 4
+)
+
+var _StageOrdered = [StageCount]Stage{
+	StageDraft,
+	StageReview,
+	StagePublished,
+	StageArchived,
+}
+
+// Ordinal returns the position of x in the declaration order of Stage, not counting
+// skipped values, or -1 if x is not a declared value.
+func (x Stage) Ordinal() int {
+	switch x {
+	case StageDraft:
+		return 0
+	case StageReview:
+		return 1
+	case StagePublished:
+		return 2
+	case StageArchived:
+		return 3
+	}
+	return -1
+}
+
+// Next returns the Stage declared after x.
+// It returns x and false if x is not a declared value or is StageMax.
+func (x Stage) Next() (Stage, bool) {
+	i := x.Ordinal()
+	if i < 0 || i == StageCount-1 {
+		return x, false
+	}
+	return _StageOrdered[i+1], true
+}
+
+// Prev returns the Stage declared before x.
+// It returns x and false if x is not a declared value or is StageMin.
+func (x Stage) Prev() (Stage, bool) {
+	i := x.Ordinal()
+	if i < 1 {
+		return x, false
+	}
+	return _StageOrdered[i-1], true
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at the same
+// position as, or after other.  Values that are not declared sort before the declared
+// ones, and by their own value when neither is declared.
+func (x Stage) Compare(other Stage) int {
+	a, b := x.Ordinal(), other.Ordinal()
+	if a < 0 && b < 0 {
+		switch {
+		case x < other:
+			return -1
+		case x > other:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+var _StageValue = map[string]Stage{
+	"draft":     StageDraft,
+	"review":    StageReview,
+	"published": StagePublished,
+	"archived":  StageArchived,
+}
+
+// ParseStage attempts to convert a string to a Stage.
+func ParseStage(name string) (Stage, error) {
+	if x, ok := _StageValue[name]; ok {
+		return x, nil
+	}
+	return Stage(""), fmt.Errorf("%s is %w", name, ErrInvalidStage)
+}
+
+// ParseStageBytes attempts to convert a byte slice to a Stage, without allocating
+// when name is valid.
+func ParseStageBytes(name []byte) (Stage, error) {
+	if x, ok := _StageValue[string(name)]; ok {
+		return x, nil
+	}
+	return Stage(""), fmt.Errorf("%s is %w", name, ErrInvalidStage)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Stage) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Stage) UnmarshalText(text []byte) error {
+	tmp, err := ParseStageBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Stage) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,78 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityOrdinal(t *testing.T) {
+	assert.Equal(t, SeverityDebug, SeverityMin)
+	assert.Equal(t, SeverityError, SeverityMax)
+	assert.Equal(t, 4, SeverityCount)
+
+	assert.Equal(t, 0, SeverityDebug.Ordinal())
+	assert.Equal(t, 2, SeverityWarn.Ordinal())
+	assert.Equal(t, -1, Severity(21).Ordinal())
+
+	next, ok := SeverityInfo.Next()
+	assert.True(t, ok)
+	assert.Equal(t, SeverityWarn, next)
+
+	next, ok = SeverityError.Next()
+	assert.False(t, ok)
+	assert.Equal(t, SeverityError, next)
+
+	prev, ok := SeverityWarn.Prev()
+	assert.True(t, ok)
+	assert.Equal(t, SeverityInfo, prev)
+
+	_, ok = SeverityDebug.Prev()
+	assert.False(t, ok)
+	_, ok = Severity(21).Next()
+	assert.False(t, ok)
+
+	var all []Severity
+	for x, ok := SeverityMin, true; ok; x, ok = x.Next() {
+		all = append(all, x)
+	}
+	assert.Equal(t, []Severity{SeverityDebug, SeverityInfo, SeverityWarn, SeverityError}, all)
+}
+
+func TestStageCompare(t *testing.T) {
+	assert.Equal(t, StageDraft, StageMin)
+	assert.Equal(t, StageArchived, StageMax)
+	assert.Equal(t, 4, StageCount)
+
+	// Declaration order, not alphabetical order
+	assert.Equal(t, -1, StageReview.Compare(StageArchived))
+	assert.Equal(t, 1, StagePublished.Compare(StageDraft))
+	assert.Equal(t, 0, StageReview.Compare(StageReview))
+	assert.Equal(t, -1, Stage("unknown").Compare(StageDraft))
+	assert.Equal(t, 1, Stage("b").Compare(Stage("a")))
+
+	stages := []Stage{StageArchived, StageDraft, StagePublished, StageReview}
+	sort.Slice(stages, func(i, j int) bool { return stages[i].Compare(stages[j]) < 0 })
+	assert.Equal(t, []Stage{StageDraft, StageReview, StagePublished, StageArchived}, stages)
+
+	next, ok := StageReview.Next()
+	assert.True(t, ok)
+	assert.Equal(t, StagePublished, next)
+}
+
+func TestSeasonWrap(t *testing.T) {
+	next, ok := SeasonWinter.Next()
+	assert.True(t, ok)
+	assert.Equal(t, SeasonSpring, next)
+
+	prev, ok := SeasonSpring.Prev()
+	assert.True(t, ok)
+	assert.Equal(t, SeasonWinter, prev)
+
+	_, ok = Season(9).Next()
+	assert.False(t, ok)
+}
@@ -0,0 +1,7 @@
+//go:generate ../bin/go-enum --ordinal-wrap -b example
+
+package example
+
+// Season is an enumeration whose Next and Prev wrap around.
+// ENUM(spring, summer, autumn, winter)
+type Season int
@@ -0,0 +1,151 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// SeasonSpring is a Season of type Spring.
+	SeasonSpring Season = iota
+	// SeasonSummer is a Season of type Summer.
+	SeasonSummer
+	// SeasonAutumn is a Season of type Autumn.
+	SeasonAutumn
+	// SeasonWinter is a Season of type Winter.
+	SeasonWinter
+)
+
+var ErrInvalidSeason = errors.New("not a valid Season")
+
+const _SeasonName = "springsummerautumnwinter"
+
+var _SeasonMap = map[Season]string{
+	SeasonSpring: _SeasonName[0:6],
+	SeasonSummer: _SeasonName[6:12],
+	SeasonAutumn: _SeasonName[12:18],
+	SeasonWinter: _SeasonName[18:24],
+}
+
+// String implements the Stringer interface.
+func (x Season) String() string {
+	if str, ok := _SeasonMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Season(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Season) IsValid() bool {
+	_, ok := _SeasonMap[x]
+	return ok
+}
+
+const (
+	// SeasonMin is the first declared Season.
+	SeasonMin = SeasonSpring
+	// SeasonMax is the last declared Season.
+	SeasonMax = SeasonWinter
+	// SeasonCount is the number of declared Season values.
+	SeasonCount = 4
+)
+
+var _SeasonOrdered = [SeasonCount]Season{
+	SeasonSpring,
+	SeasonSummer,
+	SeasonAutumn,
+	SeasonWinter,
+}
+
+// Ordinal returns the position of x in the declaration order of Season, not counting
+// skipped values, or -1 if x is not a declared value.
+func (x Season) Ordinal() int {
+	switch x {
+	case SeasonSpring:
+		return 0
+	case SeasonSummer:
+		return 1
+	case SeasonAutumn:
+		return 2
+	case SeasonWinter:
+		return 3
+	}
+	return -1
+}
+
+// Next returns the Season declared after x, wrapping around from SeasonMax to SeasonMin.
+// It returns x and false if x is not a declared value.
+func (x Season) Next() (Season, bool) {
+	i := x.Ordinal()
+	if i < 0 {
+		return x, false
+	}
+	return _SeasonOrdered[(i+1)%SeasonCount], true
+}
+
+// Prev returns the Season declared before x, wrapping around from SeasonMin to SeasonMax.
+// It returns x and false if x is not a declared value.
+func (x Season) Prev() (Season, bool) {
+	i := x.Ordinal()
+	if i < 0 {
+		return x, false
+	}
+	return _SeasonOrdered[(i+SeasonCount-1)%SeasonCount], true
+}
+
+// Compare returns -1, 0 or +1 depending on whether x is declared before, at the same
+// position as, or after other.  Values that are not declared sort before the declared
+// ones, and by their own value when neither is declared.
+func (x Season) Compare(other Season) int {
+	a, b := x.Ordinal(), other.Ordinal()
+	if a < 0 && b < 0 {
+		switch {
+		case x < other:
+			return -1
+		case x > other:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+var _SeasonValue = map[string]Season{
+	_SeasonName[0:6]:   SeasonSpring,
+	_SeasonName[6:12]:  SeasonSummer,
+	_SeasonName[12:18]: SeasonAutumn,
+	_SeasonName[18:24]: SeasonWinter,
+}
+
+// ParseSeason attempts to convert a string to a Season.
+func ParseSeason(name string) (Season, error) {
+	if x, ok := _SeasonValue[name]; ok {
+		return x, nil
+	}
+	return Season(0), fmt.Errorf("%s is %w", name, ErrInvalidSeason)
+}
+
+// ParseSeasonBytes attempts to convert a byte slice to a Season, without allocating
+// when name is valid.
+func ParseSeasonBytes(name []byte) (Season, error) {
+	if x, ok := _SeasonValue[string(name)]; ok {
+		return x, nil
+	}
+	return Season(0), fmt.Errorf("%s is %w", name, ErrInvalidSeason)
+}
@@ -64,6 +64,15 @@ func ParseShop(name string) (Shop, error) {
 	return Shop(""), fmt.Errorf("%s is %w", name, ErrInvalidShop)
 }
 
+// ParseShopBytes attempts to convert a byte slice to a Shop, without allocating
+// when name is valid.
+func ParseShopBytes(name []byte) (Shop, error) {
+	if x, ok := _ShopValue[string(name)]; ok {
+		return x, nil
+	}
+	return Shop(""), fmt.Errorf("%s is %w", name, ErrInvalidShop)
+}
+
 // MarshalText implements the text marshaller method.
 func (x Shop) MarshalText() ([]byte, error) {
 	return []byte(string(x)), nil
@@ -71,7 +80,7 @@ func (x Shop) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *Shop) UnmarshalText(text []byte) error {
-	tmp, err := ParseShop(string(text))
+	tmp, err := ParseShopBytes(text)
 	if err != nil {
 		return err
 	}
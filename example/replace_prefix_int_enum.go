@@ -75,6 +75,15 @@ func ParseIntShop(name string) (IntShop, error) {
 	return IntShop(0), fmt.Errorf("%s is %w", name, ErrInvalidIntShop)
 }
 
+// ParseIntShopBytes attempts to convert a byte slice to a IntShop, without allocating
+// when name is valid.
+func ParseIntShopBytes(name []byte) (IntShop, error) {
+	if x, ok := _IntShopValue[string(name)]; ok {
+		return x, nil
+	}
+	return IntShop(0), fmt.Errorf("%s is %w", name, ErrInvalidIntShop)
+}
+
 // MarshalText implements the text marshaller method.
 func (x IntShop) MarshalText() ([]byte, error) {
 	return []byte(x.String()), nil
@@ -82,8 +91,7 @@ func (x IntShop) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *IntShop) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseIntShop(name)
+	tmp, err := ParseIntShopBytes(text)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --tests --marshal --sql --flag --ptr --mustparse --names --values -b example
+
+package example
+
+// Metal is an enumeration with generated round trip tests and fuzz targets.
+// ENUM(gold, silver, copper, iron)
+type Metal int
+
+// Moon is a string enumeration with generated round trip tests and fuzz targets.
+// ENUM(luna, phobos, deimos, europa = Jupiter II)
+type Moon string
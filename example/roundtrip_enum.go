@@ -0,0 +1,394 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MetalGold is a Metal of type Gold.
+	MetalGold Metal = iota
+	// MetalSilver is a Metal of type Silver.
+	MetalSilver
+	// MetalCopper is a Metal of type Copper.
+	MetalCopper
+	// MetalIron is a Metal of type Iron.
+	MetalIron
+)
+
+var ErrInvalidMetal = fmt.Errorf("not a valid Metal, try [%s]", strings.Join(_MetalNames, ", "))
+
+const _MetalName = "goldsilvercopperiron"
+
+var _MetalNames = []string{
+	_MetalName[0:4],
+	_MetalName[4:10],
+	_MetalName[10:16],
+	_MetalName[16:20],
+}
+
+// MetalNames returns a list of possible string values of Metal.
+func MetalNames() []string {
+	tmp := make([]string, len(_MetalNames))
+	copy(tmp, _MetalNames)
+	return tmp
+}
+
+// MetalValues returns a list of the values for Metal
+func MetalValues() []Metal {
+	return []Metal{
+		MetalGold,
+		MetalSilver,
+		MetalCopper,
+		MetalIron,
+	}
+}
+
+var _MetalMap = map[Metal]string{
+	MetalGold:   _MetalName[0:4],
+	MetalSilver: _MetalName[4:10],
+	MetalCopper: _MetalName[10:16],
+	MetalIron:   _MetalName[16:20],
+}
+
+// String implements the Stringer interface.
+func (x Metal) String() string {
+	if str, ok := _MetalMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Metal(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Metal) IsValid() bool {
+	_, ok := _MetalMap[x]
+	return ok
+}
+
+var _MetalValue = map[string]Metal{
+	_MetalName[0:4]:   MetalGold,
+	_MetalName[4:10]:  MetalSilver,
+	_MetalName[10:16]: MetalCopper,
+	_MetalName[16:20]: MetalIron,
+}
+
+// ParseMetal attempts to convert a string to a Metal.
+func ParseMetal(name string) (Metal, error) {
+	if x, ok := _MetalValue[name]; ok {
+		return x, nil
+	}
+	return Metal(0), fmt.Errorf("%s is %w", name, ErrInvalidMetal)
+}
+
+// ParseMetalBytes attempts to convert a byte slice to a Metal, without allocating
+// when name is valid.
+func ParseMetalBytes(name []byte) (Metal, error) {
+	if x, ok := _MetalValue[string(name)]; ok {
+		return x, nil
+	}
+	return Metal(0), fmt.Errorf("%s is %w", name, ErrInvalidMetal)
+}
+
+// MustParseMetal converts a string to a Metal, and panics if is not valid.
+func MustParseMetal(name string) Metal {
+	val, err := ParseMetal(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+func (x Metal) Ptr() *Metal {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x Metal) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Metal) UnmarshalText(text []byte) error {
+	tmp, err := ParseMetalBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Metal) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errMetalNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Metal) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Metal(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = Metal(v)
+	case string:
+		*x, err = ParseMetal(v)
+	case []byte:
+		*x, err = ParseMetalBytes(v)
+	case Metal:
+		*x = v
+	case int:
+		*x = Metal(v)
+	case *Metal:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = Metal(v)
+	case uint64:
+		*x = Metal(v)
+	case *int:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = Metal(*v)
+	case *int64:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = Metal(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = Metal(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = Metal(*v)
+	case *uint:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = Metal(*v)
+	case *uint64:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x = Metal(*v)
+	case *string:
+		if v == nil {
+			return errMetalNilPtr
+		}
+		*x, err = ParseMetal(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Metal) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *Metal) Set(val string) error {
+	v, err := ParseMetal(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Metal) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Metal) Type() string {
+	return "Metal"
+}
+
+const (
+	// MoonLuna is a Moon of type luna.
+	MoonLuna Moon = "luna"
+	// MoonPhobos is a Moon of type phobos.
+	MoonPhobos Moon = "phobos"
+	// MoonDeimos is a Moon of type deimos.
+	MoonDeimos Moon = "deimos"
+	// MoonEuropa is a Moon of type europa.
+	MoonEuropa Moon = "Jupiter II"
+)
+
+var ErrInvalidMoon = fmt.Errorf("not a valid Moon, try [%s]", strings.Join(_MoonNames, ", "))
+
+var _MoonNames = []string{
+	string(MoonLuna),
+	string(MoonPhobos),
+	string(MoonDeimos),
+	string(MoonEuropa),
+}
+
+// MoonNames returns a list of possible string values of Moon.
+func MoonNames() []string {
+	tmp := make([]string, len(_MoonNames))
+	copy(tmp, _MoonNames)
+	return tmp
+}
+
+// MoonValues returns a list of the values for Moon
+func MoonValues() []Moon {
+	return []Moon{
+		MoonLuna,
+		MoonPhobos,
+		MoonDeimos,
+		MoonEuropa,
+	}
+}
+
+// String implements the Stringer interface.
+func (x Moon) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Moon) IsValid() bool {
+	_, err := ParseMoon(string(x))
+	return err == nil
+}
+
+var _MoonValue = map[string]Moon{
+	"luna":       MoonLuna,
+	"phobos":     MoonPhobos,
+	"deimos":     MoonDeimos,
+	"Jupiter II": MoonEuropa,
+}
+
+// ParseMoon attempts to convert a string to a Moon.
+func ParseMoon(name string) (Moon, error) {
+	if x, ok := _MoonValue[name]; ok {
+		return x, nil
+	}
+	return Moon(""), fmt.Errorf("%s is %w", name, ErrInvalidMoon)
+}
+
+// ParseMoonBytes attempts to convert a byte slice to a Moon, without allocating
+// when name is valid.
+func ParseMoonBytes(name []byte) (Moon, error) {
+	if x, ok := _MoonValue[string(name)]; ok {
+		return x, nil
+	}
+	return Moon(""), fmt.Errorf("%s is %w", name, ErrInvalidMoon)
+}
+
+// MustParseMoon converts a string to a Moon, and panics if is not valid.
+func MustParseMoon(name string) Moon {
+	val, err := ParseMoon(name)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+func (x Moon) Ptr() *Moon {
+	return &x
+}
+
+// MarshalText implements the text marshaller method.
+func (x Moon) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Moon) UnmarshalText(text []byte) error {
+	tmp, err := ParseMoonBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Moon) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errMoonNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Moon) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Moon("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseMoon(v)
+	case []byte:
+		*x, err = ParseMoonBytes(v)
+	case Moon:
+		*x = v
+	case *Moon:
+		if v == nil {
+			return errMoonNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errMoonNilPtr
+		}
+		*x, err = ParseMoon(*v)
+	default:
+		return errors.New("invalid type for Moon")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Moon) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// Set implements the Golang flag.Value interface func.
+func (x *Moon) Set(val string) error {
+	v, err := ParseMoon(val)
+	*x = v
+	return err
+}
+
+// Get implements the Golang flag.Getter interface func.
+func (x *Moon) Get() interface{} {
+	return *x
+}
+
+// Type implements the github.com/spf13/pFlag Value interface.
+func (x *Moon) Type() string {
+	return "Moon"
+}
@@ -0,0 +1,221 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	json "encoding/json"
+	"testing"
+)
+
+var _MetalTestCases = [...]struct {
+	name  string
+	value Metal
+}{
+	{"gold", MetalGold},
+	{"silver", MetalSilver},
+	{"copper", MetalCopper},
+	{"iron", MetalIron},
+}
+
+func TestMetalRoundTrip(t *testing.T) {
+	for _, tc := range _MetalTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.value.String(); got != tc.name {
+				t.Errorf("String() = %q, want %q", got, tc.name)
+			}
+			if !tc.value.IsValid() {
+				t.Errorf("IsValid() = false, want true")
+			}
+			if got, err := ParseMetal(tc.name); err != nil || got != tc.value {
+				t.Errorf("ParseMetal(%q) = %v, %v, want %v", tc.name, got, err, tc.value)
+			}
+			if got, err := ParseMetalBytes([]byte(tc.name)); err != nil || got != tc.value {
+				t.Errorf("ParseMetalBytes(%q) = %v, %v, want %v", tc.name, got, err, tc.value)
+			}
+			if got := MustParseMetal(tc.name); got != tc.value {
+				t.Errorf("MustParseMetal(%q) = %v, want %v", tc.name, got, tc.value)
+			}
+			if got := tc.value.Ptr(); *got != tc.value {
+				t.Errorf("Ptr() = %v, want %v", *got, tc.value)
+			}
+			hasName := false
+			for _, name := range MetalNames() {
+				hasName = hasName || name == tc.name
+			}
+			if !hasName {
+				t.Errorf("MetalNames() = %v, missing %q", MetalNames(), tc.name)
+			}
+			hasValue := false
+			for _, value := range MetalValues() {
+				hasValue = hasValue || value == tc.value
+			}
+			if !hasValue {
+				t.Errorf("MetalValues() = %v, missing %v", MetalValues(), tc.value)
+			}
+
+			text, err := tc.value.MarshalText()
+			if err != nil || string(text) != tc.name {
+				t.Errorf("MarshalText() = %q, %v, want %q", text, err, tc.name)
+			}
+			var fromText Metal
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tc.value {
+				t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, fromText, err, tc.value)
+			}
+
+			raw, err := json.Marshal(tc.value)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var fromJSON Metal
+			if err := json.Unmarshal(raw, &fromJSON); err != nil || fromJSON != tc.value {
+				t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", raw, fromJSON, err, tc.value)
+			}
+
+			v, err := tc.value.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			var scanned Metal
+			if err := scanned.Scan(v); err != nil || scanned != tc.value {
+				t.Errorf("Scan(%v) = %v, %v, want %v", v, scanned, err, tc.value)
+			}
+
+			var flagged Metal
+			if err := flagged.Set(tc.name); err != nil || flagged != tc.value {
+				t.Errorf("Set(%q) = %v, %v, want %v", tc.name, flagged, err, tc.value)
+			}
+		})
+	}
+}
+
+func FuzzParseMetal(f *testing.F) {
+	for _, tc := range _MetalTestCases {
+		f.Add(tc.name)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		x, err := ParseMetal(name)
+		fromBytes, bytesErr := ParseMetalBytes([]byte(name))
+		if fromBytes != x || (bytesErr == nil) != (err == nil) {
+			t.Fatalf("ParseMetalBytes(%q) = %v, %v, but ParseMetal = %v, %v", name, fromBytes, bytesErr, x, err)
+		}
+		if err != nil {
+			return
+		}
+		if !x.IsValid() {
+			t.Fatalf("ParseMetal(%q) = %v, which is not valid", name, x)
+		}
+		if again, err := ParseMetal(x.String()); err != nil || again != x {
+			t.Fatalf("ParseMetal(%q) = %v, %v, want %v", x.String(), again, err, x)
+		}
+	})
+}
+
+var _MoonTestCases = [...]struct {
+	name  string
+	value Moon
+}{
+	{"luna", MoonLuna},
+	{"phobos", MoonPhobos},
+	{"deimos", MoonDeimos},
+	{"Jupiter II", MoonEuropa},
+}
+
+func TestMoonRoundTrip(t *testing.T) {
+	for _, tc := range _MoonTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.value.String(); got != tc.name {
+				t.Errorf("String() = %q, want %q", got, tc.name)
+			}
+			if !tc.value.IsValid() {
+				t.Errorf("IsValid() = false, want true")
+			}
+			if got, err := ParseMoon(tc.name); err != nil || got != tc.value {
+				t.Errorf("ParseMoon(%q) = %v, %v, want %v", tc.name, got, err, tc.value)
+			}
+			if got, err := ParseMoonBytes([]byte(tc.name)); err != nil || got != tc.value {
+				t.Errorf("ParseMoonBytes(%q) = %v, %v, want %v", tc.name, got, err, tc.value)
+			}
+			if got := MustParseMoon(tc.name); got != tc.value {
+				t.Errorf("MustParseMoon(%q) = %v, want %v", tc.name, got, tc.value)
+			}
+			if got := tc.value.Ptr(); *got != tc.value {
+				t.Errorf("Ptr() = %v, want %v", *got, tc.value)
+			}
+			hasName := false
+			for _, name := range MoonNames() {
+				hasName = hasName || name == tc.name
+			}
+			if !hasName {
+				t.Errorf("MoonNames() = %v, missing %q", MoonNames(), tc.name)
+			}
+			hasValue := false
+			for _, value := range MoonValues() {
+				hasValue = hasValue || value == tc.value
+			}
+			if !hasValue {
+				t.Errorf("MoonValues() = %v, missing %v", MoonValues(), tc.value)
+			}
+
+			text, err := tc.value.MarshalText()
+			if err != nil || string(text) != tc.name {
+				t.Errorf("MarshalText() = %q, %v, want %q", text, err, tc.name)
+			}
+			var fromText Moon
+			if err := fromText.UnmarshalText(text); err != nil || fromText != tc.value {
+				t.Errorf("UnmarshalText(%q) = %v, %v, want %v", text, fromText, err, tc.value)
+			}
+
+			raw, err := json.Marshal(tc.value)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			var fromJSON Moon
+			if err := json.Unmarshal(raw, &fromJSON); err != nil || fromJSON != tc.value {
+				t.Errorf("json.Unmarshal(%s) = %v, %v, want %v", raw, fromJSON, err, tc.value)
+			}
+
+			v, err := tc.value.Value()
+			if err != nil {
+				t.Fatalf("Value() error = %v", err)
+			}
+			var scanned Moon
+			if err := scanned.Scan(v); err != nil || scanned != tc.value {
+				t.Errorf("Scan(%v) = %v, %v, want %v", v, scanned, err, tc.value)
+			}
+
+			var flagged Moon
+			if err := flagged.Set(tc.name); err != nil || flagged != tc.value {
+				t.Errorf("Set(%q) = %v, %v, want %v", tc.name, flagged, err, tc.value)
+			}
+		})
+	}
+}
+
+func FuzzParseMoon(f *testing.F) {
+	for _, tc := range _MoonTestCases {
+		f.Add(tc.name)
+	}
+	f.Fuzz(func(t *testing.T, name string) {
+		x, err := ParseMoon(name)
+		fromBytes, bytesErr := ParseMoonBytes([]byte(name))
+		if fromBytes != x || (bytesErr == nil) != (err == nil) {
+			t.Fatalf("ParseMoonBytes(%q) = %v, %v, but ParseMoon = %v, %v", name, fromBytes, bytesErr, x, err)
+		}
+		if err != nil {
+			return
+		}
+		if !x.IsValid() {
+			t.Fatalf("ParseMoon(%q) = %v, which is not valid", name, x)
+		}
+		if again, err := ParseMoon(x.String()); err != nil || again != x {
+			t.Fatalf("ParseMoon(%q) = %v, %v, want %v", x.String(), again, err, x)
+		}
+	})
+}
@@ -0,0 +1,15 @@
+//go:generate ../bin/go-enum --set --marshal --sql --iter -b example
+
+package example
+
+// Permission is an enumeration whose set is backed by a bitset.
+// ENUM(read, write, _, execute, admin)
+type Permission int
+
+// HTTPStatus is an enumeration whose values are too large for a bitset.
+// ENUM(ok=200, created=201, not_found=404, teapot=418)
+type HTTPStatus int
+
+// Topping is a string enumeration with a set.
+// ENUM(cheese, ham, pineapple, olives)
+type Topping string
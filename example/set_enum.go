@@ -0,0 +1,1193 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"database/sql/driver"
+	json "encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+const (
+	// HTTPStatusOk is a HTTPStatus of type Ok.
+	HTTPStatusOk HTTPStatus = iota + 200
+	// HTTPStatusCreated is a HTTPStatus of type Created.
+	HTTPStatusCreated
+	// HTTPStatusNotFound is a HTTPStatus of type Not_found.
+	HTTPStatusNotFound HTTPStatus = iota + 402
+	// HTTPStatusTeapot is a HTTPStatus of type Teapot.
+	HTTPStatusTeapot HTTPStatus = iota + 415
+)
+
+var ErrInvalidHTTPStatus = errors.New("not a valid HTTPStatus")
+
+const _HTTPStatusName = "okcreatednot_foundteapot"
+
+var _HTTPStatusMap = map[HTTPStatus]string{
+	HTTPStatusOk:       _HTTPStatusName[0:2],
+	HTTPStatusCreated:  _HTTPStatusName[2:9],
+	HTTPStatusNotFound: _HTTPStatusName[9:18],
+	HTTPStatusTeapot:   _HTTPStatusName[18:24],
+}
+
+// String implements the Stringer interface.
+func (x HTTPStatus) String() string {
+	if str, ok := _HTTPStatusMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("HTTPStatus(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x HTTPStatus) IsValid() bool {
+	_, ok := _HTTPStatusMap[x]
+	return ok
+}
+
+// HTTPStatusAll returns an iterator over the declared HTTPStatus values, in declaration order.
+func HTTPStatusAll() iter.Seq[HTTPStatus] {
+	return func(yield func(HTTPStatus) bool) {
+		for _, x := range [...]HTTPStatus{
+			HTTPStatusOk,
+			HTTPStatusCreated,
+			HTTPStatusNotFound,
+			HTTPStatusTeapot,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// HTTPStatusEntries returns an iterator over the names and values of HTTPStatus, in declaration order.
+func HTTPStatusEntries() iter.Seq2[string, HTTPStatus] {
+	return func(yield func(string, HTTPStatus) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value HTTPStatus
+		}{
+			{_HTTPStatusName[0:2], HTTPStatusOk},
+			{_HTTPStatusName[2:9], HTTPStatusCreated},
+			{_HTTPStatusName[9:18], HTTPStatusNotFound},
+			{_HTTPStatusName[18:24], HTTPStatusTeapot},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _HTTPStatusValue = map[string]HTTPStatus{
+	_HTTPStatusName[0:2]:   HTTPStatusOk,
+	_HTTPStatusName[2:9]:   HTTPStatusCreated,
+	_HTTPStatusName[9:18]:  HTTPStatusNotFound,
+	_HTTPStatusName[18:24]: HTTPStatusTeapot,
+}
+
+// ParseHTTPStatus attempts to convert a string to a HTTPStatus.
+func ParseHTTPStatus(name string) (HTTPStatus, error) {
+	if x, ok := _HTTPStatusValue[name]; ok {
+		return x, nil
+	}
+	return HTTPStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidHTTPStatus)
+}
+
+// ParseHTTPStatusBytes attempts to convert a byte slice to a HTTPStatus, without allocating
+// when name is valid.
+func ParseHTTPStatusBytes(name []byte) (HTTPStatus, error) {
+	if x, ok := _HTTPStatusValue[string(name)]; ok {
+		return x, nil
+	}
+	return HTTPStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidHTTPStatus)
+}
+
+// MarshalText implements the text marshaller method.
+func (x HTTPStatus) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *HTTPStatus) UnmarshalText(text []byte) error {
+	tmp, err := ParseHTTPStatusBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *HTTPStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errHTTPStatusNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *HTTPStatus) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = HTTPStatus(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = HTTPStatus(v)
+	case string:
+		*x, err = ParseHTTPStatus(v)
+	case []byte:
+		*x, err = ParseHTTPStatusBytes(v)
+	case HTTPStatus:
+		*x = v
+	case int:
+		*x = HTTPStatus(v)
+	case *HTTPStatus:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = HTTPStatus(v)
+	case uint64:
+		*x = HTTPStatus(v)
+	case *int:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = HTTPStatus(*v)
+	case *int64:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = HTTPStatus(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = HTTPStatus(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = HTTPStatus(*v)
+	case *uint:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = HTTPStatus(*v)
+	case *uint64:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x = HTTPStatus(*v)
+	case *string:
+		if v == nil {
+			return errHTTPStatusNilPtr
+		}
+		*x, err = ParseHTTPStatus(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x HTTPStatus) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// HTTPStatusSet is a set of HTTPStatus values.  The zero value is an empty set that is ready to use.
+// It is backed by a map, so a copy of a non-empty set shares its values with the original.  Use Clone to get an
+// independent copy.
+type HTTPStatusSet struct {
+	m map[HTTPStatus]struct{}
+}
+
+var _HTTPStatusSetOrder = [...]HTTPStatus{
+	HTTPStatusOk,
+	HTTPStatusCreated,
+	HTTPStatusNotFound,
+	HTTPStatusTeapot,
+}
+
+// NewHTTPStatusSet returns a set holding the given values.
+func NewHTTPStatusSet(values ...HTTPStatus) HTTPStatusSet {
+	var s HTTPStatusSet
+	s.Add(values...)
+	return s
+}
+
+// Add adds the values to the set.  Values that are not declared are ignored.
+func (s *HTTPStatusSet) Add(values ...HTTPStatus) {
+	for _, x := range values {
+		if !x.IsValid() {
+			continue
+		}
+		if s.m == nil {
+			s.m = make(map[HTTPStatus]struct{}, len(values))
+		}
+		s.m[x] = struct{}{}
+	}
+}
+
+// Remove removes the values from the set.
+func (s *HTTPStatusSet) Remove(values ...HTTPStatus) {
+	for _, x := range values {
+		delete(s.m, x)
+	}
+}
+
+// Clone returns a copy of the set that doesn't share any state with s.
+func (s HTTPStatusSet) Clone() HTTPStatusSet {
+	if s.m == nil {
+		return HTTPStatusSet{}
+	}
+	out := HTTPStatusSet{m: make(map[HTTPStatus]struct{}, len(s.m))}
+	for x := range s.m {
+		out.m[x] = struct{}{}
+	}
+	return out
+}
+
+// Contains reports whether x is in the set.
+func (s HTTPStatusSet) Contains(x HTTPStatus) bool {
+	_, ok := s.m[x]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s HTTPStatusSet) Len() int {
+	return len(s.m)
+}
+
+// Union returns a new set with the values that are in either s or other.
+func (s HTTPStatusSet) Union(other HTTPStatusSet) HTTPStatusSet {
+	var out HTTPStatusSet
+	for x := range s.m {
+		out.Add(x)
+	}
+	for x := range other.m {
+		out.Add(x)
+	}
+	return out
+}
+
+// Intersect returns a new set with the values that are in both s and other.
+func (s HTTPStatusSet) Intersect(other HTTPStatusSet) HTTPStatusSet {
+	var out HTTPStatusSet
+	for x := range s.m {
+		if other.Contains(x) {
+			out.Add(x)
+		}
+	}
+	return out
+}
+
+// Difference returns a new set with the values that are in s but not in other.
+func (s HTTPStatusSet) Difference(other HTTPStatusSet) HTTPStatusSet {
+	var out HTTPStatusSet
+	for x := range s.m {
+		if !other.Contains(x) {
+			out.Add(x)
+		}
+	}
+	return out
+}
+
+// Values returns the values in the set, in declaration order.
+func (s HTTPStatusSet) Values() []HTTPStatus {
+	values := make([]HTTPStatus, 0, s.Len())
+	for _, x := range _HTTPStatusSetOrder {
+		if s.Contains(x) {
+			values = append(values, x)
+		}
+	}
+	return values
+}
+
+// All returns an iterator over the values in the set, in declaration order.
+func (s HTTPStatusSet) All() iter.Seq[HTTPStatus] {
+	return func(yield func(HTTPStatus) bool) {
+		for _, x := range _HTTPStatusSetOrder {
+			if s.Contains(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in the set, in declaration order.
+func (s HTTPStatusSet) names() []string {
+	names := make([]string, 0, s.Len())
+	for _, x := range _HTTPStatusSetOrder {
+		if s.Contains(x) {
+			names = append(names, x.String())
+		}
+	}
+	return names
+}
+
+// String implements the Stringer interface.
+func (s HTTPStatusSet) String() string {
+	return "[" + strings.Join(s.names(), ", ") + "]"
+}
+
+// ParseHTTPStatusSet converts a comma separated list of names to a HTTPStatusSet.
+// Spaces around the names and empty entries are ignored.
+func ParseHTTPStatusSet(list string) (HTTPStatusSet, error) {
+	var s HTTPStatusSet
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		x, err := ParseHTTPStatus(name)
+		if err != nil {
+			return HTTPStatusSet{}, err
+		}
+		s.Add(x)
+	}
+	return s, nil
+}
+
+// MarshalText implements the text marshaller method, writing the names separated by commas.
+func (s HTTPStatusSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements the text unmarshaller method, reading names separated by commas.
+func (s *HTTPStatusSet) UnmarshalText(text []byte) error {
+	tmp, err := ParseHTTPStatusSet(string(text))
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// MarshalJSON implements the json marshaller method, writing an array of names.
+func (s HTTPStatusSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.names())
+}
+
+// UnmarshalJSON implements the json unmarshaller method, reading an array of names.
+func (s *HTTPStatusSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var tmp HTTPStatusSet
+	for _, name := range names {
+		x, err := ParseHTTPStatus(name)
+		if err != nil {
+			return err
+		}
+		tmp.Add(x)
+	}
+	*s = tmp
+	return nil
+}
+
+// Scan implements the Scanner interface.  It reads names separated by commas, either as is
+// or as a Postgres array such as {red,green}.
+func (s *HTTPStatusSet) Scan(value interface{}) error {
+	var list string
+	switch v := value.(type) {
+	case nil:
+		*s = HTTPStatusSet{}
+		return nil
+	case string:
+		list = v
+	case []byte:
+		list = string(v)
+	default:
+		return fmt.Errorf("can not scan %T into a HTTPStatusSet", value)
+	}
+	if strings.HasPrefix(list, "{") && strings.HasSuffix(list, "}") {
+		list = strings.ReplaceAll(list[1:len(list)-1], `"`, "")
+	}
+	tmp, err := ParseHTTPStatusSet(list)
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// Value implements the driver Valuer interface, writing the names separated by commas.
+func (s HTTPStatusSet) Value() (driver.Value, error) {
+	return strings.Join(s.names(), ","), nil
+}
+
+const (
+	// PermissionRead is a Permission of type Read.
+	PermissionRead Permission = iota
+	// PermissionWrite is a Permission of type Write.
+	PermissionWrite
+	// Skipped value.
+	_
+	// PermissionExecute is a Permission of type Execute.
+	PermissionExecute
+	// PermissionAdmin is a Permission of type Admin.
+	PermissionAdmin
+)
+
+var ErrInvalidPermission = errors.New("not a valid Permission")
+
+const _PermissionName = "readwriteexecuteadmin"
+
+var _PermissionMap = map[Permission]string{
+	PermissionRead:    _PermissionName[0:4],
+	PermissionWrite:   _PermissionName[4:9],
+	PermissionExecute: _PermissionName[9:16],
+	PermissionAdmin:   _PermissionName[16:21],
+}
+
+// String implements the Stringer interface.
+func (x Permission) String() string {
+	if str, ok := _PermissionMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Permission(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Permission) IsValid() bool {
+	_, ok := _PermissionMap[x]
+	return ok
+}
+
+// PermissionAll returns an iterator over the declared Permission values, in declaration order.
+func PermissionAll() iter.Seq[Permission] {
+	return func(yield func(Permission) bool) {
+		for _, x := range [...]Permission{
+			PermissionRead,
+			PermissionWrite,
+			PermissionExecute,
+			PermissionAdmin,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// PermissionEntries returns an iterator over the names and values of Permission, in declaration order.
+func PermissionEntries() iter.Seq2[string, Permission] {
+	return func(yield func(string, Permission) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value Permission
+		}{
+			{_PermissionName[0:4], PermissionRead},
+			{_PermissionName[4:9], PermissionWrite},
+			{_PermissionName[9:16], PermissionExecute},
+			{_PermissionName[16:21], PermissionAdmin},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _PermissionValue = map[string]Permission{
+	_PermissionName[0:4]:   PermissionRead,
+	_PermissionName[4:9]:   PermissionWrite,
+	_PermissionName[9:16]:  PermissionExecute,
+	_PermissionName[16:21]: PermissionAdmin,
+}
+
+// ParsePermission attempts to convert a string to a Permission.
+func ParsePermission(name string) (Permission, error) {
+	if x, ok := _PermissionValue[name]; ok {
+		return x, nil
+	}
+	return Permission(0), fmt.Errorf("%s is %w", name, ErrInvalidPermission)
+}
+
+// ParsePermissionBytes attempts to convert a byte slice to a Permission, without allocating
+// when name is valid.
+func ParsePermissionBytes(name []byte) (Permission, error) {
+	if x, ok := _PermissionValue[string(name)]; ok {
+		return x, nil
+	}
+	return Permission(0), fmt.Errorf("%s is %w", name, ErrInvalidPermission)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Permission) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Permission) UnmarshalText(text []byte) error {
+	tmp, err := ParsePermissionBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Permission) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errPermissionNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Permission) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Permission(0)
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case int64:
+		*x = Permission(v)
+	case string:
+		*x, err = ParsePermission(v)
+	case []byte:
+		*x, err = ParsePermissionBytes(v)
+	case Permission:
+		*x = v
+	case int:
+		*x = Permission(v)
+	case *Permission:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = *v
+	case uint:
+		*x = Permission(v)
+	case uint64:
+		*x = Permission(v)
+	case *int:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = Permission(*v)
+	case *int64:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = Permission(*v)
+	case float64: // json marshals everything as a float64 if it's a number
+		*x = Permission(v)
+	case *float64: // json marshals everything as a float64 if it's a number
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = Permission(*v)
+	case *uint:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = Permission(*v)
+	case *uint64:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x = Permission(*v)
+	case *string:
+		if v == nil {
+			return errPermissionNilPtr
+		}
+		*x, err = ParsePermission(*v)
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Permission) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// PermissionSet is a set of Permission values.  The zero value is an empty set that is ready to use.
+// It is backed by a bitset, as every Permission value fits in a uint64.
+type PermissionSet struct {
+	bits uint64
+}
+
+var _PermissionSetOrder = [...]Permission{
+	PermissionRead,
+	PermissionWrite,
+	PermissionExecute,
+	PermissionAdmin,
+}
+
+// NewPermissionSet returns a set holding the given values.
+func NewPermissionSet(values ...Permission) PermissionSet {
+	var s PermissionSet
+	s.Add(values...)
+	return s
+}
+
+// Add adds the values to the set.  Values that are not declared are ignored.
+func (s *PermissionSet) Add(values ...Permission) {
+	for _, x := range values {
+		if !x.IsValid() {
+			continue
+		}
+		s.bits |= 1 << uint(x)
+	}
+}
+
+// Remove removes the values from the set.
+func (s *PermissionSet) Remove(values ...Permission) {
+	for _, x := range values {
+		if x.IsValid() {
+			s.bits &^= 1 << uint(x)
+		}
+	}
+}
+
+// Clone returns a copy of the set that doesn't share any state with s.
+func (s PermissionSet) Clone() PermissionSet {
+	return s
+}
+
+// Contains reports whether x is in the set.
+func (s PermissionSet) Contains(x Permission) bool {
+	return x.IsValid() && s.bits&(1<<uint(x)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s PermissionSet) Len() int {
+	return bits.OnesCount64(s.bits)
+}
+
+// Union returns a new set with the values that are in either s or other.
+func (s PermissionSet) Union(other PermissionSet) PermissionSet {
+	return PermissionSet{bits: s.bits | other.bits}
+}
+
+// Intersect returns a new set with the values that are in both s and other.
+func (s PermissionSet) Intersect(other PermissionSet) PermissionSet {
+	return PermissionSet{bits: s.bits & other.bits}
+}
+
+// Difference returns a new set with the values that are in s but not in other.
+func (s PermissionSet) Difference(other PermissionSet) PermissionSet {
+	return PermissionSet{bits: s.bits &^ other.bits}
+}
+
+// Values returns the values in the set, in declaration order.
+func (s PermissionSet) Values() []Permission {
+	values := make([]Permission, 0, s.Len())
+	for _, x := range _PermissionSetOrder {
+		if s.Contains(x) {
+			values = append(values, x)
+		}
+	}
+	return values
+}
+
+// All returns an iterator over the values in the set, in declaration order.
+func (s PermissionSet) All() iter.Seq[Permission] {
+	return func(yield func(Permission) bool) {
+		for _, x := range _PermissionSetOrder {
+			if s.Contains(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in the set, in declaration order.
+func (s PermissionSet) names() []string {
+	names := make([]string, 0, s.Len())
+	for _, x := range _PermissionSetOrder {
+		if s.Contains(x) {
+			names = append(names, x.String())
+		}
+	}
+	return names
+}
+
+// String implements the Stringer interface.
+func (s PermissionSet) String() string {
+	return "[" + strings.Join(s.names(), ", ") + "]"
+}
+
+// ParsePermissionSet converts a comma separated list of names to a PermissionSet.
+// Spaces around the names and empty entries are ignored.
+func ParsePermissionSet(list string) (PermissionSet, error) {
+	var s PermissionSet
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		x, err := ParsePermission(name)
+		if err != nil {
+			return PermissionSet{}, err
+		}
+		s.Add(x)
+	}
+	return s, nil
+}
+
+// MarshalText implements the text marshaller method, writing the names separated by commas.
+func (s PermissionSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements the text unmarshaller method, reading names separated by commas.
+func (s *PermissionSet) UnmarshalText(text []byte) error {
+	tmp, err := ParsePermissionSet(string(text))
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// MarshalJSON implements the json marshaller method, writing an array of names.
+func (s PermissionSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.names())
+}
+
+// UnmarshalJSON implements the json unmarshaller method, reading an array of names.
+func (s *PermissionSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var tmp PermissionSet
+	for _, name := range names {
+		x, err := ParsePermission(name)
+		if err != nil {
+			return err
+		}
+		tmp.Add(x)
+	}
+	*s = tmp
+	return nil
+}
+
+// Scan implements the Scanner interface.  It reads names separated by commas, either as is
+// or as a Postgres array such as {red,green}.
+func (s *PermissionSet) Scan(value interface{}) error {
+	var list string
+	switch v := value.(type) {
+	case nil:
+		*s = PermissionSet{}
+		return nil
+	case string:
+		list = v
+	case []byte:
+		list = string(v)
+	default:
+		return fmt.Errorf("can not scan %T into a PermissionSet", value)
+	}
+	if strings.HasPrefix(list, "{") && strings.HasSuffix(list, "}") {
+		list = strings.ReplaceAll(list[1:len(list)-1], `"`, "")
+	}
+	tmp, err := ParsePermissionSet(list)
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// Value implements the driver Valuer interface, writing the names separated by commas.
+func (s PermissionSet) Value() (driver.Value, error) {
+	return strings.Join(s.names(), ","), nil
+}
+
+const (
+	// ToppingCheese is a Topping of type cheese.
+	ToppingCheese Topping = "cheese"
+	// ToppingHam is a Topping of type ham.
+	ToppingHam Topping = "ham"
+	// ToppingPineapple is a Topping of type pineapple.
+	ToppingPineapple Topping = "pineapple"
+	// ToppingOlives is a Topping of type olives.
+	ToppingOlives Topping = "olives"
+)
+
+var ErrInvalidTopping = errors.New("not a valid Topping")
+
+// String implements the Stringer interface.
+func (x Topping) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Topping) IsValid() bool {
+	_, err := ParseTopping(string(x))
+	return err == nil
+}
+
+// ToppingAll returns an iterator over the declared Topping values, in declaration order.
+func ToppingAll() iter.Seq[Topping] {
+	return func(yield func(Topping) bool) {
+		for _, x := range [...]Topping{
+			ToppingCheese,
+			ToppingHam,
+			ToppingPineapple,
+			ToppingOlives,
+		} {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ToppingEntries returns an iterator over the names and values of Topping, in declaration order.
+func ToppingEntries() iter.Seq2[string, Topping] {
+	return func(yield func(string, Topping) bool) {
+		for _, e := range [...]struct {
+			name  string
+			value Topping
+		}{
+			{"cheese", ToppingCheese},
+			{"ham", ToppingHam},
+			{"pineapple", ToppingPineapple},
+			{"olives", ToppingOlives},
+		} {
+			if !yield(e.name, e.value) {
+				return
+			}
+		}
+	}
+}
+
+var _ToppingValue = map[string]Topping{
+	"cheese":    ToppingCheese,
+	"ham":       ToppingHam,
+	"pineapple": ToppingPineapple,
+	"olives":    ToppingOlives,
+}
+
+// ParseTopping attempts to convert a string to a Topping.
+func ParseTopping(name string) (Topping, error) {
+	if x, ok := _ToppingValue[name]; ok {
+		return x, nil
+	}
+	return Topping(""), fmt.Errorf("%s is %w", name, ErrInvalidTopping)
+}
+
+// ParseToppingBytes attempts to convert a byte slice to a Topping, without allocating
+// when name is valid.
+func ParseToppingBytes(name []byte) (Topping, error) {
+	if x, ok := _ToppingValue[string(name)]; ok {
+		return x, nil
+	}
+	return Topping(""), fmt.Errorf("%s is %w", name, ErrInvalidTopping)
+}
+
+// MarshalText implements the text marshaller method.
+func (x Topping) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Topping) UnmarshalText(text []byte) error {
+	tmp, err := ParseToppingBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Topping) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+var errToppingNilPtr = errors.New("value pointer is nil") // one per type for package clashes
+
+// Scan implements the Scanner interface.
+func (x *Topping) Scan(value interface{}) (err error) {
+	if value == nil {
+		*x = Topping("")
+		return
+	}
+
+	// A wider range of scannable types.
+	// driver.Value values at the top of the list for expediency
+	switch v := value.(type) {
+	case string:
+		*x, err = ParseTopping(v)
+	case []byte:
+		*x, err = ParseToppingBytes(v)
+	case Topping:
+		*x = v
+	case *Topping:
+		if v == nil {
+			return errToppingNilPtr
+		}
+		*x = *v
+	case *string:
+		if v == nil {
+			return errToppingNilPtr
+		}
+		*x, err = ParseTopping(*v)
+	default:
+		return errors.New("invalid type for Topping")
+	}
+
+	return
+}
+
+// Value implements the driver Valuer interface.
+func (x Topping) Value() (driver.Value, error) {
+	return x.String(), nil
+}
+
+// ToppingSet is a set of Topping values.  The zero value is an empty set that is ready to use.
+// It is backed by a map, so a copy of a non-empty set shares its values with the original.  Use Clone to get an
+// independent copy.
+type ToppingSet struct {
+	m map[Topping]struct{}
+}
+
+var _ToppingSetOrder = [...]Topping{
+	ToppingCheese,
+	ToppingHam,
+	ToppingPineapple,
+	ToppingOlives,
+}
+
+// NewToppingSet returns a set holding the given values.
+func NewToppingSet(values ...Topping) ToppingSet {
+	var s ToppingSet
+	s.Add(values...)
+	return s
+}
+
+// Add adds the values to the set.  Values that are not declared are ignored.
+func (s *ToppingSet) Add(values ...Topping) {
+	for _, x := range values {
+		if !x.IsValid() {
+			continue
+		}
+		if s.m == nil {
+			s.m = make(map[Topping]struct{}, len(values))
+		}
+		s.m[x] = struct{}{}
+	}
+}
+
+// Remove removes the values from the set.
+func (s *ToppingSet) Remove(values ...Topping) {
+	for _, x := range values {
+		delete(s.m, x)
+	}
+}
+
+// Clone returns a copy of the set that doesn't share any state with s.
+func (s ToppingSet) Clone() ToppingSet {
+	if s.m == nil {
+		return ToppingSet{}
+	}
+	out := ToppingSet{m: make(map[Topping]struct{}, len(s.m))}
+	for x := range s.m {
+		out.m[x] = struct{}{}
+	}
+	return out
+}
+
+// Contains reports whether x is in the set.
+func (s ToppingSet) Contains(x Topping) bool {
+	_, ok := s.m[x]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s ToppingSet) Len() int {
+	return len(s.m)
+}
+
+// Union returns a new set with the values that are in either s or other.
+func (s ToppingSet) Union(other ToppingSet) ToppingSet {
+	var out ToppingSet
+	for x := range s.m {
+		out.Add(x)
+	}
+	for x := range other.m {
+		out.Add(x)
+	}
+	return out
+}
+
+// Intersect returns a new set with the values that are in both s and other.
+func (s ToppingSet) Intersect(other ToppingSet) ToppingSet {
+	var out ToppingSet
+	for x := range s.m {
+		if other.Contains(x) {
+			out.Add(x)
+		}
+	}
+	return out
+}
+
+// Difference returns a new set with the values that are in s but not in other.
+func (s ToppingSet) Difference(other ToppingSet) ToppingSet {
+	var out ToppingSet
+	for x := range s.m {
+		if !other.Contains(x) {
+			out.Add(x)
+		}
+	}
+	return out
+}
+
+// Values returns the values in the set, in declaration order.
+func (s ToppingSet) Values() []Topping {
+	values := make([]Topping, 0, s.Len())
+	for _, x := range _ToppingSetOrder {
+		if s.Contains(x) {
+			values = append(values, x)
+		}
+	}
+	return values
+}
+
+// All returns an iterator over the values in the set, in declaration order.
+func (s ToppingSet) All() iter.Seq[Topping] {
+	return func(yield func(Topping) bool) {
+		for _, x := range _ToppingSetOrder {
+			if s.Contains(x) && !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// names returns the names of the values in the set, in declaration order.
+func (s ToppingSet) names() []string {
+	names := make([]string, 0, s.Len())
+	for _, x := range _ToppingSetOrder {
+		if s.Contains(x) {
+			names = append(names, x.String())
+		}
+	}
+	return names
+}
+
+// String implements the Stringer interface.
+func (s ToppingSet) String() string {
+	return "[" + strings.Join(s.names(), ", ") + "]"
+}
+
+// ParseToppingSet converts a comma separated list of names to a ToppingSet.
+// Spaces around the names and empty entries are ignored.
+func ParseToppingSet(list string) (ToppingSet, error) {
+	var s ToppingSet
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		x, err := ParseTopping(name)
+		if err != nil {
+			return ToppingSet{}, err
+		}
+		s.Add(x)
+	}
+	return s, nil
+}
+
+// MarshalText implements the text marshaller method, writing the names separated by commas.
+func (s ToppingSet) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(s.names(), ",")), nil
+}
+
+// UnmarshalText implements the text unmarshaller method, reading names separated by commas.
+func (s *ToppingSet) UnmarshalText(text []byte) error {
+	tmp, err := ParseToppingSet(string(text))
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// MarshalJSON implements the json marshaller method, writing an array of names.
+func (s ToppingSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.names())
+}
+
+// UnmarshalJSON implements the json unmarshaller method, reading an array of names.
+func (s *ToppingSet) UnmarshalJSON(b []byte) error {
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		return err
+	}
+	var tmp ToppingSet
+	for _, name := range names {
+		x, err := ParseTopping(name)
+		if err != nil {
+			return err
+		}
+		tmp.Add(x)
+	}
+	*s = tmp
+	return nil
+}
+
+// Scan implements the Scanner interface.  It reads names separated by commas, either as is
+// or as a Postgres array such as {red,green}.
+func (s *ToppingSet) Scan(value interface{}) error {
+	var list string
+	switch v := value.(type) {
+	case nil:
+		*s = ToppingSet{}
+		return nil
+	case string:
+		list = v
+	case []byte:
+		list = string(v)
+	default:
+		return fmt.Errorf("can not scan %T into a ToppingSet", value)
+	}
+	if strings.HasPrefix(list, "{") && strings.HasSuffix(list, "}") {
+		list = strings.ReplaceAll(list[1:len(list)-1], `"`, "")
+	}
+	tmp, err := ParseToppingSet(list)
+	if err != nil {
+		return err
+	}
+	*s = tmp
+	return nil
+}
+
+// Value implements the driver Valuer interface, writing the names separated by commas.
+func (s ToppingSet) Value() (driver.Value, error) {
+	return strings.Join(s.names(), ","), nil
+}
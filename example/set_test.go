@@ -0,0 +1,139 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionSet(t *testing.T) {
+	var s PermissionSet
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Contains(PermissionRead))
+
+	s.Add(PermissionAdmin, PermissionRead, Permission(2), Permission(99))
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains(PermissionRead))
+	assert.False(t, s.Contains(Permission(2)))
+	assert.Equal(t, []Permission{PermissionRead, PermissionAdmin}, s.Values())
+	assert.Equal(t, "[read, admin]", s.String())
+
+	other := NewPermissionSet(PermissionWrite, PermissionAdmin)
+	assert.Equal(t, []Permission{PermissionRead, PermissionWrite, PermissionAdmin}, s.Union(other).Values())
+	assert.Equal(t, []Permission{PermissionAdmin}, s.Intersect(other).Values())
+	assert.Equal(t, []Permission{PermissionRead}, s.Difference(other).Values())
+
+	s.Remove(PermissionRead, Permission(99))
+	assert.Equal(t, []Permission{PermissionAdmin}, s.Values())
+
+	var all []Permission
+	for x := range s.Union(other).All() {
+		all = append(all, x)
+	}
+	assert.Equal(t, []Permission{PermissionWrite, PermissionAdmin}, all)
+}
+
+func TestHTTPStatusSet(t *testing.T) {
+	s := NewHTTPStatusSet(HTTPStatusTeapot, HTTPStatusOk, HTTPStatus(500))
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, []HTTPStatus{HTTPStatusOk, HTTPStatusTeapot}, s.Values())
+
+	other := NewHTTPStatusSet(HTTPStatusOk, HTTPStatusCreated)
+	assert.Equal(t, []HTTPStatus{HTTPStatusOk, HTTPStatusCreated, HTTPStatusTeapot}, s.Union(other).Values())
+	assert.Equal(t, []HTTPStatus{HTTPStatusOk}, s.Intersect(other).Values())
+	assert.Equal(t, []HTTPStatus{HTTPStatusTeapot}, s.Difference(other).Values())
+
+	s.Remove(HTTPStatusOk)
+	assert.False(t, s.Contains(HTTPStatusOk))
+
+	var empty HTTPStatusSet
+	empty.Remove(HTTPStatusOk)
+	assert.Equal(t, "[]", empty.String())
+}
+
+func TestSetCopySemantics(t *testing.T) {
+	// A bitset backed set is a value, so a plain copy is independent.
+	perms := NewPermissionSet(PermissionRead)
+	permsCopy := perms
+	permsCopy.Add(PermissionWrite)
+	assert.Equal(t, []Permission{PermissionRead}, perms.Values())
+
+	// A map backed set shares its map with a plain copy, and Clone makes an independent one.
+	statuses := NewHTTPStatusSet(HTTPStatusOk)
+	shared := statuses
+	shared.Add(HTTPStatusCreated)
+	assert.Equal(t, []HTTPStatus{HTTPStatusOk, HTTPStatusCreated}, statuses.Values())
+
+	clone := statuses.Clone()
+	clone.Add(HTTPStatusTeapot)
+	clone.Remove(HTTPStatusOk)
+	assert.Equal(t, []HTTPStatus{HTTPStatusOk, HTTPStatusCreated}, statuses.Values())
+	assert.Equal(t, []HTTPStatus{HTTPStatusCreated, HTTPStatusTeapot}, clone.Values())
+
+	var empty HTTPStatusSet
+	emptyClone := empty.Clone()
+	emptyClone.Add(HTTPStatusOk)
+	assert.Equal(t, 0, empty.Len())
+}
+
+func TestToppingSetMarshal(t *testing.T) {
+	type pizza struct {
+		Toppings ToppingSet `json:"toppings"`
+	}
+	p := pizza{Toppings: NewToppingSet(ToppingPineapple, ToppingCheese)}
+
+	raw, err := json.Marshal(p)
+	require.NoError(t, err)
+	assert.Equal(t, `{"toppings":["cheese","pineapple"]}`, string(raw))
+
+	var decoded pizza
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, p, decoded)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"toppings":null}`), &decoded))
+	assert.Equal(t, 0, decoded.Toppings.Len())
+
+	err = json.Unmarshal([]byte(`{"toppings":["anchovies"]}`), &decoded)
+	assert.ErrorIs(t, err, ErrInvalidTopping)
+
+	text, err := p.Toppings.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "cheese,pineapple", string(text))
+
+	var s ToppingSet
+	require.NoError(t, s.UnmarshalText([]byte("olives, ham,")))
+	assert.Equal(t, []Topping{ToppingHam, ToppingOlives}, s.Values())
+}
+
+func TestToppingSetSQL(t *testing.T) {
+	s := NewToppingSet(ToppingOlives, ToppingHam)
+	val, err := s.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "ham,olives", val)
+
+	tests := map[string]interface{}{
+		"comma separated": "ham,olives",
+		"bytes":           []byte("olives,ham"),
+		"postgres array":  `{"ham",olives}`,
+	}
+	for name, value := range tests {
+		t.Run(name, func(t *testing.T) {
+			var scanned ToppingSet
+			require.NoError(t, scanned.Scan(value))
+			assert.Equal(t, s, scanned)
+		})
+	}
+
+	var scanned ToppingSet
+	require.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, 0, scanned.Len())
+	assert.Error(t, scanned.Scan(42))
+
+	_, err = ParsePermissionSet("read,launch")
+	assert.ErrorIs(t, err, ErrInvalidPermission)
+}
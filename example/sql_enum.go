@@ -65,6 +65,15 @@ func ParseProjectStatus(name string) (ProjectStatus, error) {
 	return ProjectStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidProjectStatus)
 }
 
+// ParseProjectStatusBytes attempts to convert a byte slice to a ProjectStatus, without allocating
+// when name is valid.
+func ParseProjectStatusBytes(name []byte) (ProjectStatus, error) {
+	if x, ok := _ProjectStatusValue[string(name)]; ok {
+		return x, nil
+	}
+	return ProjectStatus(0), fmt.Errorf("%s is %w", name, ErrInvalidProjectStatus)
+}
+
 func (x ProjectStatus) Ptr() *ProjectStatus {
 	return &x
 }
@@ -76,8 +85,7 @@ func (x ProjectStatus) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *ProjectStatus) UnmarshalText(text []byte) error {
-	name := string(text)
-	tmp, err := ParseProjectStatus(name)
+	tmp, err := ParseProjectStatusBytes(text)
 	if err != nil {
 		return err
 	}
@@ -116,7 +124,7 @@ func (x *ProjectStatus) Scan(value interface{}) (err error) {
 			}
 		}
 	case []byte:
-		*x, err = ParseProjectStatus(string(v))
+		*x, err = ParseProjectStatusBytes(v)
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(string(v)); verr == nil {
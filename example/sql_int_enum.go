@@ -72,6 +72,15 @@ func ParseImageType(name string) (ImageType, error) {
 	return ImageType(0), fmt.Errorf("%s is %w", name, ErrInvalidImageType)
 }
 
+// ParseImageTypeBytes attempts to convert a byte slice to a ImageType, without allocating
+// when name is valid.
+func ParseImageTypeBytes(name []byte) (ImageType, error) {
+	if x, ok := _ImageTypeValue[string(name)]; ok {
+		return x, nil
+	}
+	return ImageType(0), fmt.Errorf("%s is %w", name, ErrInvalidImageType)
+}
+
 var errImageTypeNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 // Scan implements the Scanner interface.
@@ -95,7 +104,7 @@ func (x *ImageType) Scan(value interface{}) (err error) {
 			}
 		}
 	case []byte:
-		*x, err = ParseImageType(string(v))
+		*x, err = ParseImageTypeBytes(v)
 		if err != nil {
 			// try parsing the integer value as a string
 			if val, verr := strconv.Atoi(string(v)); verr == nil {
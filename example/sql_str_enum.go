@@ -67,6 +67,15 @@ func ParseJobState(name string) (JobState, error) {
 	return JobState(0), fmt.Errorf("%s is %w", name, ErrInvalidJobState)
 }
 
+// ParseJobStateBytes attempts to convert a byte slice to a JobState, without allocating
+// when name is valid.
+func ParseJobStateBytes(name []byte) (JobState, error) {
+	if x, ok := _JobStateValue[string(name)]; ok {
+		return x, nil
+	}
+	return JobState(0), fmt.Errorf("%s is %w", name, ErrInvalidJobState)
+}
+
 var errJobStateNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 // Scan implements the Scanner interface.
@@ -84,7 +93,7 @@ func (x *JobState) Scan(value interface{}) (err error) {
 	case string:
 		*x, err = ParseJobState(v)
 	case []byte:
-		*x, err = ParseJobState(string(v))
+		*x, err = ParseJobStateBytes(v)
 	case JobState:
 		*x = v
 	case int:
@@ -73,6 +73,15 @@ func ParseGreekGod(name string) (GreekGod, error) {
 	return GreekGod(""), fmt.Errorf("%s is %w", name, ErrInvalidGreekGod)
 }
 
+// ParseGreekGodBytes attempts to convert a byte slice to a GreekGod, without allocating
+// when name is valid.
+func ParseGreekGodBytes(name []byte) (GreekGod, error) {
+	if x, ok := _GreekGodValue[string(name)]; ok {
+		return x, nil
+	}
+	return GreekGod(""), fmt.Errorf("%s is %w", name, ErrInvalidGreekGod)
+}
+
 var errGreekGodNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 var sqlIntGreekGodMap = map[int64]GreekGod{
@@ -116,7 +125,7 @@ func (x *GreekGod) Scan(value interface{}) (err error) {
 			*x, err = lookupSqlIntGreekGod(val)
 		} else {
 			// try parsing the value as a string
-			*x, err = ParseGreekGod(string(v))
+			*x, err = ParseGreekGodBytes(v)
 		}
 	case GreekGod:
 		*x = v
@@ -269,6 +278,15 @@ func ParseGreekGodCustom(name string) (GreekGodCustom, error) {
 	return GreekGodCustom(""), fmt.Errorf("%s is %w", name, ErrInvalidGreekGodCustom)
 }
 
+// ParseGreekGodCustomBytes attempts to convert a byte slice to a GreekGodCustom, without allocating
+// when name is valid.
+func ParseGreekGodCustomBytes(name []byte) (GreekGodCustom, error) {
+	if x, ok := _GreekGodCustomValue[string(name)]; ok {
+		return x, nil
+	}
+	return GreekGodCustom(""), fmt.Errorf("%s is %w", name, ErrInvalidGreekGodCustom)
+}
+
 var errGreekGodCustomNilPtr = errors.New("value pointer is nil") // one per type for package clashes
 
 var sqlIntGreekGodCustomMap = map[int64]GreekGodCustom{
@@ -312,7 +330,7 @@ func (x *GreekGodCustom) Scan(value interface{}) (err error) {
 			*x, err = lookupSqlIntGreekGodCustom(val)
 		} else {
 			// try parsing the value as a string
-			*x, err = ParseGreekGodCustom(string(v))
+			*x, err = ParseGreekGodCustomBytes(v)
 		}
 	case GreekGodCustom:
 		*x = v
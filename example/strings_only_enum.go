@@ -69,13 +69,49 @@ var _StrStateValue = map[string]StrState{
 	"error":     StrStateFailed,
 }
 
+// foldStrState finds the StrState for name ignoring case, without allocating.
+// Names are only compared to keys of the same length, so case foldings that change
+// the length of the UTF-8 encoding do not match.
+func foldStrState(name string) (StrState, bool) {
+	switch len(name) {
+	case 5:
+		if strings.EqualFold(name, "error") {
+			return StrStateFailed, true
+		}
+	case 7:
+		if strings.EqualFold(name, "pending") {
+			return StrStatePending, true
+		}
+		if strings.EqualFold(name, "running") {
+			return StrStateRunning, true
+		}
+	case 9:
+		if strings.EqualFold(name, "completed") {
+			return StrStateCompleted, true
+		}
+	}
+	return "", false
+}
+
 // ParseStrState attempts to convert a string to a StrState.
 func ParseStrState(name string) (StrState, error) {
 	if x, ok := _StrStateValue[name]; ok {
 		return x, nil
 	}
-	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of lowercasing a string if we don't need to.
-	if x, ok := _StrStateValue[strings.ToLower(name)]; ok {
+	// Case insensitive parse, do a separate lookup to prevent unnecessary cost of folding the case if we don't need to.
+	if x, ok := foldStrState(name); ok {
+		return x, nil
+	}
+	return StrState(""), fmt.Errorf("%s is %w", name, ErrInvalidStrState)
+}
+
+// ParseStrStateBytes attempts to convert a byte slice to a StrState, without allocating
+// when name is valid.
+func ParseStrStateBytes(name []byte) (StrState, error) {
+	if x, ok := _StrStateValue[string(name)]; ok {
+		return x, nil
+	}
+	if x, ok := foldStrState(string(name)); ok {
 		return x, nil
 	}
 	return StrState(""), fmt.Errorf("%s is %w", name, ErrInvalidStrState)
@@ -101,7 +137,7 @@ func (x StrState) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller method.
 func (x *StrState) UnmarshalText(text []byte) error {
-	tmp, err := ParseStrState(string(text))
+	tmp, err := ParseStrStateBytes(text)
 	if err != nil {
 		return err
 	}
@@ -132,7 +168,7 @@ func (x *StrState) Scan(value interface{}) (err error) {
 	case string:
 		*x, err = ParseStrState(v)
 	case []byte:
-		*x, err = ParseStrState(string(v))
+		*x, err = ParseStrStateBytes(v)
 	case StrState:
 		*x = v
 	case *StrState:
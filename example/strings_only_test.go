@@ -256,3 +256,20 @@ func TestStrStateSQLExtras(t *testing.T) {
 		})
 	}
 }
+
+func TestStrStateParseBytes(t *testing.T) {
+	var x StrState
+	var value interface{} = []byte("Completed")
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = x.Scan(value)
+	})
+	assert.Equal(t, StrStateCompleted, x)
+	assert.Zero(t, allocs)
+
+	x, err := ParseStrStateBytes([]byte("ERROR"))
+	require.NoError(t, err)
+	assert.Equal(t, StrStateFailed, x)
+
+	_, err = ParseStrStateBytes([]byte("failed"))
+	assert.ErrorIs(t, err, ErrInvalidStrState)
+}
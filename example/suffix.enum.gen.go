@@ -44,3 +44,12 @@ func ParseSuffix(name string) (Suffix, error) {
 	}
 	return Suffix(""), fmt.Errorf("%s is %w", name, ErrInvalidSuffix)
 }
+
+// ParseSuffixBytes attempts to convert a byte slice to a Suffix, without allocating
+// when name is valid.
+func ParseSuffixBytes(name []byte) (Suffix, error) {
+	if x, ok := _SuffixValue[string(name)]; ok {
+		return x, nil
+	}
+	return Suffix(""), fmt.Errorf("%s is %w", name, ErrInvalidSuffix)
+}
@@ -44,3 +44,12 @@ func ParseSuffixTest(name string) (SuffixTest, error) {
 	}
 	return SuffixTest(""), fmt.Errorf("%s is %w", name, ErrInvalidSuffixTest)
 }
+
+// ParseSuffixTestBytes attempts to convert a byte slice to a SuffixTest, without allocating
+// when name is valid.
+func ParseSuffixTestBytes(name []byte) (SuffixTest, error) {
+	if x, ok := _SuffixTestValue[string(name)]; ok {
+		return x, nil
+	}
+	return SuffixTest(""), fmt.Errorf("%s is %w", name, ErrInvalidSuffixTest)
+}
@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --marshal --suggest -b example
+
+package example
+
+// Rainbow is an enumeration whose parse errors suggest the closest color.
+// ENUM(red, orange, yellow, green, blue, indigo, violet)
+type Rainbow int
+
+// ConfigFormat is a string enumeration whose parse errors suggest the closest format.
+// ENUM(json, yaml, toml, xml)
+type ConfigFormat string
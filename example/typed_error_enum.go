@@ -0,0 +1,353 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ConfigFormatJson is a ConfigFormat of type json.
+	ConfigFormatJson ConfigFormat = "json"
+	// ConfigFormatYaml is a ConfigFormat of type yaml.
+	ConfigFormatYaml ConfigFormat = "yaml"
+	// ConfigFormatToml is a ConfigFormat of type toml.
+	ConfigFormatToml ConfigFormat = "toml"
+	// ConfigFormatXml is a ConfigFormat of type xml.
+	ConfigFormatXml ConfigFormat = "xml"
+)
+
+var ErrInvalidConfigFormat = errors.New("not a valid ConfigFormat")
+
+var _ConfigFormatNames = []string{
+	string(ConfigFormatJson),
+	string(ConfigFormatYaml),
+	string(ConfigFormatToml),
+	string(ConfigFormatXml),
+}
+
+// String implements the Stringer interface.
+func (x ConfigFormat) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x ConfigFormat) IsValid() bool {
+	_, err := ParseConfigFormat(string(x))
+	return err == nil
+}
+
+var _ConfigFormatValue = map[string]ConfigFormat{
+	"json": ConfigFormatJson,
+	"yaml": ConfigFormatYaml,
+	"toml": ConfigFormatToml,
+	"xml":  ConfigFormatXml,
+}
+
+// ParseConfigFormat attempts to convert a string to a ConfigFormat.
+func ParseConfigFormat(name string) (ConfigFormat, error) {
+	if x, ok := _ConfigFormatValue[name]; ok {
+		return x, nil
+	}
+	return ConfigFormat(""), invalidConfigFormatError(name)
+}
+
+// ParseConfigFormatBytes attempts to convert a byte slice to a ConfigFormat, without allocating
+// when name is valid.
+func ParseConfigFormatBytes(name []byte) (ConfigFormat, error) {
+	if x, ok := _ConfigFormatValue[string(name)]; ok {
+		return x, nil
+	}
+	return ConfigFormat(""), invalidConfigFormatError(string(name))
+}
+
+// InvalidConfigFormatError is returned when a string is not a valid ConfigFormat.
+// It wraps ErrInvalidConfigFormat, so errors.Is still matches it.
+type InvalidConfigFormatError struct {
+	// Enum is the name of the enum type.
+	Enum string
+	// Input is the value that failed to parse.
+	Input string
+	// Valid lists the names that would have been accepted.
+	Valid []string
+	// Suggestion is the valid name closest to Input, or empty if none are close.
+	Suggestion string
+}
+
+// Error implements the error interface.
+func (e *InvalidConfigFormatError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("%s is %v, did you mean %q?", e.Input, ErrInvalidConfigFormat, e.Suggestion)
+	}
+	return fmt.Sprintf("%s is %v", e.Input, ErrInvalidConfigFormat)
+}
+
+// Unwrap returns ErrInvalidConfigFormat.
+func (e *InvalidConfigFormatError) Unwrap() error {
+	return ErrInvalidConfigFormat
+}
+
+func invalidConfigFormatError(input string) error {
+	return &InvalidConfigFormatError{
+		Enum:       "ConfigFormat",
+		Input:      input,
+		Valid:      append([]string(nil), _ConfigFormatNames...),
+		Suggestion: suggestConfigFormat(input),
+	}
+}
+
+// suggestConfigFormat returns the name with the smallest case insensitive edit
+// distance to input, as long as it is within about a third of the input's length.
+// Ties go to the name declared first.
+func suggestConfigFormat(input string) string {
+	in := []rune(strings.ToLower(input))
+	limit := (len(in) + 1) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	best, bestDist := "", limit+1
+	row := make([]int, 0, 32)
+	for _, name := range _ConfigFormatNames {
+		n := []rune(strings.ToLower(name))
+		row = row[:0]
+		for j := 0; j <= len(n); j++ {
+			row = append(row, j)
+		}
+		for i := 1; i <= len(in); i++ {
+			diag := row[0]
+			row[0] = i
+			for j := 1; j <= len(n); j++ {
+				dist := diag
+				if in[i-1] != n[j-1] {
+					dist++
+				}
+				if row[j]+1 < dist {
+					dist = row[j] + 1
+				}
+				if row[j-1]+1 < dist {
+					dist = row[j-1] + 1
+				}
+				diag, row[j] = row[j], dist
+			}
+		}
+		if row[len(n)] < bestDist {
+			best, bestDist = name, row[len(n)]
+		}
+	}
+	return best
+}
+
+// MarshalText implements the text marshaller method.
+func (x ConfigFormat) MarshalText() ([]byte, error) {
+	return []byte(string(x)), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *ConfigFormat) UnmarshalText(text []byte) error {
+	tmp, err := ParseConfigFormatBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *ConfigFormat) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
+
+const (
+	// RainbowRed is a Rainbow of type Red.
+	RainbowRed Rainbow = iota
+	// RainbowOrange is a Rainbow of type Orange.
+	RainbowOrange
+	// RainbowYellow is a Rainbow of type Yellow.
+	RainbowYellow
+	// RainbowGreen is a Rainbow of type Green.
+	RainbowGreen
+	// RainbowBlue is a Rainbow of type Blue.
+	RainbowBlue
+	// RainbowIndigo is a Rainbow of type Indigo.
+	RainbowIndigo
+	// RainbowViolet is a Rainbow of type Violet.
+	RainbowViolet
+)
+
+var ErrInvalidRainbow = errors.New("not a valid Rainbow")
+
+const _RainbowName = "redorangeyellowgreenblueindigoviolet"
+
+var _RainbowNames = []string{
+	_RainbowName[0:3],
+	_RainbowName[3:9],
+	_RainbowName[9:15],
+	_RainbowName[15:20],
+	_RainbowName[20:24],
+	_RainbowName[24:30],
+	_RainbowName[30:36],
+}
+
+var _RainbowMap = map[Rainbow]string{
+	RainbowRed:    _RainbowName[0:3],
+	RainbowOrange: _RainbowName[3:9],
+	RainbowYellow: _RainbowName[9:15],
+	RainbowGreen:  _RainbowName[15:20],
+	RainbowBlue:   _RainbowName[20:24],
+	RainbowIndigo: _RainbowName[24:30],
+	RainbowViolet: _RainbowName[30:36],
+}
+
+// String implements the Stringer interface.
+func (x Rainbow) String() string {
+	if str, ok := _RainbowMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Rainbow(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Rainbow) IsValid() bool {
+	_, ok := _RainbowMap[x]
+	return ok
+}
+
+var _RainbowValue = map[string]Rainbow{
+	_RainbowName[0:3]:   RainbowRed,
+	_RainbowName[3:9]:   RainbowOrange,
+	_RainbowName[9:15]:  RainbowYellow,
+	_RainbowName[15:20]: RainbowGreen,
+	_RainbowName[20:24]: RainbowBlue,
+	_RainbowName[24:30]: RainbowIndigo,
+	_RainbowName[30:36]: RainbowViolet,
+}
+
+// ParseRainbow attempts to convert a string to a Rainbow.
+func ParseRainbow(name string) (Rainbow, error) {
+	if x, ok := _RainbowValue[name]; ok {
+		return x, nil
+	}
+	return Rainbow(0), invalidRainbowError(name)
+}
+
+// ParseRainbowBytes attempts to convert a byte slice to a Rainbow, without allocating
+// when name is valid.
+func ParseRainbowBytes(name []byte) (Rainbow, error) {
+	if x, ok := _RainbowValue[string(name)]; ok {
+		return x, nil
+	}
+	return Rainbow(0), invalidRainbowError(string(name))
+}
+
+// InvalidRainbowError is returned when a string is not a valid Rainbow.
+// It wraps ErrInvalidRainbow, so errors.Is still matches it.
+type InvalidRainbowError struct {
+	// Enum is the name of the enum type.
+	Enum string
+	// Input is the value that failed to parse.
+	Input string
+	// Valid lists the names that would have been accepted.
+	Valid []string
+	// Suggestion is the valid name closest to Input, or empty if none are close.
+	Suggestion string
+}
+
+// Error implements the error interface.
+func (e *InvalidRainbowError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("%s is %v, did you mean %q?", e.Input, ErrInvalidRainbow, e.Suggestion)
+	}
+	return fmt.Sprintf("%s is %v", e.Input, ErrInvalidRainbow)
+}
+
+// Unwrap returns ErrInvalidRainbow.
+func (e *InvalidRainbowError) Unwrap() error {
+	return ErrInvalidRainbow
+}
+
+func invalidRainbowError(input string) error {
+	return &InvalidRainbowError{
+		Enum:       "Rainbow",
+		Input:      input,
+		Valid:      append([]string(nil), _RainbowNames...),
+		Suggestion: suggestRainbow(input),
+	}
+}
+
+// suggestRainbow returns the name with the smallest case insensitive edit
+// distance to input, as long as it is within about a third of the input's length.
+// Ties go to the name declared first.
+func suggestRainbow(input string) string {
+	in := []rune(strings.ToLower(input))
+	limit := (len(in) + 1) / 3
+	if limit < 1 {
+		limit = 1
+	}
+	best, bestDist := "", limit+1
+	row := make([]int, 0, 32)
+	for _, name := range _RainbowNames {
+		n := []rune(strings.ToLower(name))
+		row = row[:0]
+		for j := 0; j <= len(n); j++ {
+			row = append(row, j)
+		}
+		for i := 1; i <= len(in); i++ {
+			diag := row[0]
+			row[0] = i
+			for j := 1; j <= len(n); j++ {
+				dist := diag
+				if in[i-1] != n[j-1] {
+					dist++
+				}
+				if row[j]+1 < dist {
+					dist = row[j] + 1
+				}
+				if row[j-1]+1 < dist {
+					dist = row[j-1] + 1
+				}
+				diag, row[j] = row[j], dist
+			}
+		}
+		if row[len(n)] < bestDist {
+			best, bestDist = name, row[len(n)]
+		}
+	}
+	return best
+}
+
+// MarshalText implements the text marshaller method.
+func (x Rainbow) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller method.
+func (x *Rainbow) UnmarshalText(text []byte) error {
+	tmp, err := ParseRainbowBytes(text)
+	if err != nil {
+		return err
+	}
+	*x = tmp
+	return nil
+}
+
+// AppendText appends the textual representation of itself to the end of b
+// (allocating a larger slice if necessary) and returns the updated slice.
+//
+// Implementations must not retain b, nor mutate any bytes within b[:len(b)].
+func (x *Rainbow) AppendText(b []byte) ([]byte, error) {
+	return append(b, x.String()...), nil
+}
@@ -0,0 +1,72 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRainbowTypedError(t *testing.T) {
+	tests := map[string]struct {
+		input      string
+		suggestion string
+		message    string
+	}{
+		"typo": {
+			input:      "grene",
+			suggestion: "green",
+			message:    `grene is not a valid Rainbow, did you mean "green"?`,
+		},
+		"case": {
+			input:      "Indigo",
+			suggestion: "indigo",
+			message:    `Indigo is not a valid Rainbow, did you mean "indigo"?`,
+		},
+		"too far": {
+			input:   "purple",
+			message: "purple is not a valid Rainbow",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseRainbow(tc.input)
+			require.Error(t, err)
+			assert.ErrorIs(t, err, ErrInvalidRainbow)
+			assert.EqualError(t, err, tc.message)
+
+			var typed *InvalidRainbowError
+			require.True(t, errors.As(err, &typed))
+			assert.Equal(t, "Rainbow", typed.Enum)
+			assert.Equal(t, tc.input, typed.Input)
+			assert.Equal(t, tc.suggestion, typed.Suggestion)
+			assert.Equal(t, []string{"red", "orange", "yellow", "green", "blue", "indigo", "violet"}, typed.Valid)
+		})
+	}
+}
+
+func TestConfigFormatTypedErrorUnmarshal(t *testing.T) {
+	var x struct {
+		Format ConfigFormat `json:"format"`
+	}
+	err := json.Unmarshal([]byte(`{"format":"yml"}`), &x)
+	require.Error(t, err)
+
+	var typed *InvalidConfigFormatError
+	require.True(t, errors.As(err, &typed))
+	assert.Equal(t, "ConfigFormat", typed.Enum)
+	assert.Equal(t, "yml", typed.Input)
+	// yaml and xml are both one edit away, so the first declared wins
+	assert.Equal(t, "yaml", typed.Suggestion)
+
+	// Changing the returned names must not change the error of the next call
+	typed.Valid[0] = "changed"
+	_, err = ParseConfigFormat("yml")
+	require.True(t, errors.As(err, &typed))
+	assert.Equal(t, "json", typed.Valid[0])
+}
@@ -62,6 +62,15 @@ func ParseOceanColor(name string) (OceanColor, error) {
 	return OceanColor(0), fmt.Errorf("%s is %w", name, ErrInvalidOceanColor)
 }
 
+// ParseOceanColorBytes attempts to convert a byte slice to a OceanColor, without allocating
+// when name is valid.
+func ParseOceanColorBytes(name []byte) (OceanColor, error) {
+	if x, ok := _OceanColorValue[string(name)]; ok {
+		return x, nil
+	}
+	return OceanColor(0), fmt.Errorf("%s is %w", name, ErrInvalidOceanColor)
+}
+
 func ParseOceanColorGlobbedExample() bool {
 	return true
 }
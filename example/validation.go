@@ -0,0 +1,11 @@
+//go:generate ../bin/go-enum --validator --validate -b example
+
+package example
+
+// Shirt is an enumeration validated by go-playground/validator and ozzo-validation.
+// ENUM(small, medium, large)
+type Shirt int
+
+// Fabric is a string enumeration validated by go-playground/validator and ozzo-validation.
+// ENUM(cotton, wool, silk)
+type Fabric string
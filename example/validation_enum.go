@@ -0,0 +1,177 @@
+// Code generated by go-enum DO NOT EDIT.
+// Version: example
+// Revision: example
+// Build Date: example
+// Built By: example
+
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	// FabricCotton is a Fabric of type cotton.
+	FabricCotton Fabric = "cotton"
+	// FabricWool is a Fabric of type wool.
+	FabricWool Fabric = "wool"
+	// FabricSilk is a Fabric of type silk.
+	FabricSilk Fabric = "silk"
+)
+
+var ErrInvalidFabric = errors.New("not a valid Fabric")
+
+// String implements the Stringer interface.
+func (x Fabric) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Fabric) IsValid() bool {
+	_, err := ParseFabric(string(x))
+	return err == nil
+}
+
+var _FabricValue = map[string]Fabric{
+	"cotton": FabricCotton,
+	"wool":   FabricWool,
+	"silk":   FabricSilk,
+}
+
+// ParseFabric attempts to convert a string to a Fabric.
+func ParseFabric(name string) (Fabric, error) {
+	if x, ok := _FabricValue[name]; ok {
+		return x, nil
+	}
+	return Fabric(""), fmt.Errorf("%s is %w", name, ErrInvalidFabric)
+}
+
+// ParseFabricBytes attempts to convert a byte slice to a Fabric, without allocating
+// when name is valid.
+func ParseFabricBytes(name []byte) (Fabric, error) {
+	if x, ok := _FabricValue[string(name)]; ok {
+		return x, nil
+	}
+	return Fabric(""), fmt.Errorf("%s is %w", name, ErrInvalidFabric)
+}
+
+// Validate implements the Validatable interface of ozzo-validation, returning an error if x is
+// not a declared Fabric.
+func (x Fabric) Validate() error {
+	if x.IsValid() {
+		return nil
+	}
+	return fmt.Errorf("%v is %w", x, ErrInvalidFabric)
+}
+
+// RegisterFabricValidation registers the "fabric" tag with the validator, checking
+// that a Fabric field is declared, or that a string field is one of its names.
+// It panics if the tag can't be registered.
+func RegisterFabricValidation(v *validator.Validate) {
+	err := v.RegisterValidation("fabric", func(fl validator.FieldLevel) bool {
+		if x, ok := fl.Field().Interface().(Fabric); ok {
+			return x.IsValid()
+		}
+		if fl.Field().Kind() == reflect.String {
+			_, err := ParseFabric(fl.Field().String())
+			return err == nil
+		}
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+const (
+	// ShirtSmall is a Shirt of type Small.
+	ShirtSmall Shirt = iota
+	// ShirtMedium is a Shirt of type Medium.
+	ShirtMedium
+	// ShirtLarge is a Shirt of type Large.
+	ShirtLarge
+)
+
+var ErrInvalidShirt = errors.New("not a valid Shirt")
+
+const _ShirtName = "smallmediumlarge"
+
+var _ShirtMap = map[Shirt]string{
+	ShirtSmall:  _ShirtName[0:5],
+	ShirtMedium: _ShirtName[5:11],
+	ShirtLarge:  _ShirtName[11:16],
+}
+
+// String implements the Stringer interface.
+func (x Shirt) String() string {
+	if str, ok := _ShirtMap[x]; ok {
+		return str
+	}
+	return fmt.Sprintf("Shirt(%d)", x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Shirt) IsValid() bool {
+	_, ok := _ShirtMap[x]
+	return ok
+}
+
+var _ShirtValue = map[string]Shirt{
+	_ShirtName[0:5]:   ShirtSmall,
+	_ShirtName[5:11]:  ShirtMedium,
+	_ShirtName[11:16]: ShirtLarge,
+}
+
+// ParseShirt attempts to convert a string to a Shirt.
+func ParseShirt(name string) (Shirt, error) {
+	if x, ok := _ShirtValue[name]; ok {
+		return x, nil
+	}
+	return Shirt(0), fmt.Errorf("%s is %w", name, ErrInvalidShirt)
+}
+
+// ParseShirtBytes attempts to convert a byte slice to a Shirt, without allocating
+// when name is valid.
+func ParseShirtBytes(name []byte) (Shirt, error) {
+	if x, ok := _ShirtValue[string(name)]; ok {
+		return x, nil
+	}
+	return Shirt(0), fmt.Errorf("%s is %w", name, ErrInvalidShirt)
+}
+
+// Validate implements the Validatable interface of ozzo-validation, returning an error if x is
+// not a declared Shirt.
+func (x Shirt) Validate() error {
+	if x.IsValid() {
+		return nil
+	}
+	return fmt.Errorf("%v is %w", x, ErrInvalidShirt)
+}
+
+// RegisterShirtValidation registers the "shirt" tag with the validator, checking
+// that a Shirt field is declared, or that a string field is one of its names.
+// It panics if the tag can't be registered.
+func RegisterShirtValidation(v *validator.Validate) {
+	err := v.RegisterValidation("shirt", func(fl validator.FieldLevel) bool {
+		if x, ok := fl.Field().Interface().(Shirt); ok {
+			return x.IsValid()
+		}
+		if fl.Field().Kind() == reflect.String {
+			_, err := ParseShirt(fl.Field().String())
+			return err == nil
+		}
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+}
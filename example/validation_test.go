@@ -0,0 +1,50 @@
+//go:build example
+// +build example
+
+package example
+
+import (
+	"errors"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type order struct {
+	Size      Shirt   `validate:"shirt"`
+	SizeName  string  `validate:"shirt"`
+	Fabric    Fabric  `validate:"fabric"`
+	FabricPtr *Fabric `validate:"omitempty,fabric"`
+}
+
+func TestShirtValidator(t *testing.T) {
+	v := validator.New()
+	RegisterShirtValidation(v)
+	RegisterFabricValidation(v)
+
+	wool := FabricWool
+	assert.NoError(t, v.Struct(order{Size: ShirtLarge, SizeName: "medium", Fabric: FabricSilk, FabricPtr: &wool}))
+	assert.NoError(t, v.Struct(order{Size: ShirtSmall, SizeName: "small", Fabric: FabricCotton}))
+
+	err := v.Struct(order{Size: Shirt(7), SizeName: "huge", Fabric: Fabric("linen")})
+	var errs validator.ValidationErrors
+	require.True(t, errors.As(err, &errs))
+	var fields []string
+	for _, e := range errs {
+		fields = append(fields, e.Field())
+	}
+	assert.Equal(t, []string{"Size", "SizeName", "Fabric"}, fields)
+}
+
+func TestShirtValidate(t *testing.T) {
+	assert.NoError(t, ShirtMedium.Validate())
+	assert.ErrorIs(t, Shirt(7).Validate(), ErrInvalidShirt)
+	assert.EqualError(t, Fabric("linen").Validate(), "linen is not a valid Fabric")
+
+	// The Validate method is picked up by ozzo-validation
+	assert.NoError(t, validation.Validate(FabricWool))
+	assert.ErrorIs(t, validation.Validate(Shirt(7)), ErrInvalidShirt)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/abice/go-enum/generator"
+	"github.com/labstack/gommon/color"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/text/language"
+)
+
+type extractT struct {
+	FileNames  cli.StringSlice
+	Langs      cli.StringSlice
+	SourceLang string
+	Out        string
+	Catalog    string
+	ForceLower bool
+	ForceUpper bool
+}
+
+// extractCommand writes the messages of the Display methods to gotext translation files.
+func extractCommand(out func(format string, args ...any)) *cli.Command {
+	var argv extractT
+	return &cli.Command{
+		Name:  "extract",
+		Usage: "Extracts the names of the enums into a messages.gotext.json file for each language, to translate them for the Display method",
+		Flags: []cli.Flag{
+			&cli.StringSliceFlag{
+				Name:        "file",
+				Aliases:     []string{"f"},
+				EnvVars:     []string{"GOFILE"},
+				Usage:       "The file(s) to extract the enums from.  Use more than one flag for more files.",
+				Required:    true,
+				Destination: &argv.FileNames,
+			},
+			&cli.StringSliceFlag{
+				Name:        "lang",
+				Usage:       "The language(s) to write a translation file for.  Use more than one flag for more languages.",
+				Required:    true,
+				Destination: &argv.Langs,
+			},
+			&cli.StringFlag{
+				Name:        "source-lang",
+				Usage:       "The language of the names, which gets the names as translations.",
+				Value:       "en",
+				Destination: &argv.SourceLang,
+			},
+			&cli.StringFlag{
+				Name:        "out",
+				Usage:       "The directory holding a directory per language, with the messages.gotext.json files.",
+				Value:       "locales",
+				Destination: &argv.Out,
+			},
+			&cli.StringFlag{
+				Name:        "catalog",
+				Usage:       "Writes a go file to this path, adding the translations to the default message catalog.",
+				Destination: &argv.Catalog,
+			},
+			&cli.BoolFlag{
+				Name:        "forcelower",
+				Usage:       "Use this if the enums are generated with --forcelower.",
+				Destination: &argv.ForceLower,
+			},
+			&cli.BoolFlag{
+				Name:        "forceupper",
+				Usage:       "Use this if the enums are generated with --forceupper.",
+				Destination: &argv.ForceUpper,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			source, err := language.Parse(argv.SourceLang)
+			if err != nil {
+				return fmt.Errorf("invalid source language %q: %w", argv.SourceLang, err)
+			}
+			var langs []language.Tag
+			for _, l := range argv.Langs.Value() {
+				tag, err := language.Parse(l)
+				if err != nil {
+					return fmt.Errorf("invalid language %q: %w", l, err)
+				}
+				langs = append(langs, tag)
+			}
+
+			g := generator.NewGeneratorWithConfig(generator.GeneratorConfig{
+				ForceLower: argv.ForceLower,
+				ForceUpper: argv.ForceUpper,
+			})
+
+			var msgs []generator.DisplayMessage
+			for _, fileOption := range argv.FileNames.Value() {
+				filenames, err := globFilenames(fileOption)
+				if err != nil {
+					return err
+				}
+				for _, fileName := range filenames {
+					fileMsgs, err := g.DisplayMessagesFromFile(fileName)
+					if err != nil {
+						return fmt.Errorf("failed extracting enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+					}
+					msgs = append(msgs, fileMsgs...)
+				}
+			}
+
+			written, err := generator.UpdateMessageFiles(argv.Out, source, langs, msgs)
+			if err != nil {
+				return err
+			}
+			for _, file := range written {
+				out("go-enum extracted. file: %s\n", color.Cyan(file))
+			}
+
+			if argv.Catalog != "" {
+				pkg, err := catalogPackage(msgs)
+				if err != nil {
+					return err
+				}
+				raw, err := generator.DisplayCatalog(pkg, argv.Out, langs)
+				if err != nil {
+					return fmt.Errorf("failed generating catalog: %w", err)
+				}
+				if err := os.WriteFile(argv.Catalog, raw, 0o644); err != nil {
+					return fmt.Errorf("failed writing to file %s: %s", color.Cyan(argv.Catalog), color.Red(err))
+				}
+				out("go-enum extracted. file: %s\n", color.Cyan(argv.Catalog))
+			}
+			return nil
+		},
+	}
+}
+
+// catalogPackage returns the package of the enums the messages came from, which the catalog is
+// written in.
+func catalogPackage(msgs []generator.DisplayMessage) (string, error) {
+	var pkgs []string
+	for _, m := range msgs {
+		if !slices.Contains(pkgs, m.Package) {
+			pkgs = append(pkgs, m.Package)
+		}
+	}
+	switch len(pkgs) {
+	case 0:
+		return "", errors.New("no enums found to write a catalog for")
+	case 1:
+		return pkgs[0], nil
+	}
+	return "", fmt.Errorf("the enums are declared in more than one package (%s), so they need a catalog each", strings.Join(pkgs, ", "))
+}
@@ -4,13 +4,36 @@
 package generator
 
 import (
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
+	"io/fs"
+	"sync"
 	"text/template"
 )
 
-//go:embed enum.tmpl enum_string.tmpl
+//go:embed enum.tmpl enum_string.tmpl benchmark.tmpl test.tmpl set.tmpl map.tmpl
 var content embed.FS
 
+// embeddedTemplates returns the embedded templates, which are parsed once and cloned for each
+// generator, so generators don't parse them again.
+var embeddedTemplates = sync.OnceValue(func() *template.Template {
+	return template.Must(template.New("generator").Funcs(templateFuncs()).ParseFS(content, "*.tmpl"))
+})
+
+// embeddedTemplatesHash returns the hash of the contents of the embedded templates.
+var embeddedTemplatesHash = sync.OnceValue(func() string {
+	h := sha256.New()
+	names, _ := fs.Glob(content, "*.tmpl")
+	for _, name := range names {
+		raw, _ := content.ReadFile(name)
+		h.Write([]byte(name + "\x00"))
+		h.Write(raw)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+})
+
 func (g *Generator) addEmbeddedTemplates() {
-	g.t = template.Must(g.t.ParseFS(content, "*.tmpl"))
+	g.t = template.Must(embeddedTemplates().Clone())
 }
@@ -7,10 +7,13 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"net/url"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 	"unicode"
 
@@ -23,9 +26,17 @@ import (
 const (
 	skipHolder         = `_`
 	parseCommentPrefix = `//`
+	defaultMarker      = `[default]`
 )
 
 // Generator is responsible for generating validation files for the given in a go source file.
+//
+// A Generator is safe for concurrent use once it is constructed: the configuration and the templates
+// are only read, each call keeps its state to itself, and the shared token.FileSet is safe for
+// concurrent use.  LoadPackages is the exception that changes the generator, adding the type
+// information of the packages it loads under a lock, so it can run alongside the other methods, and
+// the enums generated once it returns use those types.  The exported fields must not be changed after
+// the first call.
 type Generator struct {
 	Version   string
 	Revision  string
@@ -36,25 +47,53 @@ type Generator struct {
 	knownTemplates    map[string]*template.Template
 	fileSet           *token.FileSet
 	userTemplateNames []string
+	// mu guards typesInfo, which LoadPackages adds to while other calls may read it.
+	mu sync.RWMutex
+	// typesInfo holds the type information of the packages loaded with LoadPackages.
+	typesInfo []*types.Info
 }
 
 // Enum holds data for a discovered enum in the parsed source
 type Enum struct {
-	Name    string
-	Prefix  string
-	Type    string
-	Values  []EnumValue
-	Comment string
+	Name    string      `json:"name"`
+	Prefix  string      `json:"prefix"`
+	Type    string      `json:"type"`
+	Values  []EnumValue `json:"values"`
+	Comment string      `json:"comment"`
+	// Hash is the hash of the name, type and ENUM declaration of the enum.
+	Hash string `json:"hash"`
+	// Position is the position of the name of the enum type, set by Inspect.
+	Position Position `json:"position"`
+	// DeclPosition is the position of the ENUM declaration in the comment, set by Inspect.
+	DeclPosition Position `json:"decl_position"`
 }
 
 // EnumValue holds the individual data for each enum value within the found enum.
 type EnumValue struct {
-	RawName      string
-	Name         string
-	PrefixedName string
-	ValueStr     string
-	ValueInt     any
-	Comment      string
+	RawName      string `json:"raw_name"`
+	Name         string `json:"name"`
+	PrefixedName string `json:"prefixed_name"`
+	ValueStr     string `json:"value_str"`
+	ValueInt     any    `json:"value_int"`
+	Comment      string `json:"comment"`
+	IsDefault    bool   `json:"is_default"`
+}
+
+// Position is a position in a source file.
+type Position struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// DefaultValue returns the value marked with `[default]` in the declaration, or nil if there isn't one.
+func (e *Enum) DefaultValue() *EnumValue {
+	for i := range e.Values {
+		if e.Values[i].IsDefault {
+			return &e.Values[i]
+		}
+	}
+	return nil
 }
 
 // NewGenerator is a constructor method for creating a new Generator with default
@@ -71,20 +110,66 @@ func NewGenerator(options ...Option) *Generator {
 }
 
 // NewGeneratorWithConfig is a constructor method for creating a new Generator with
-// a configuration struct instead of using the functional options pattern.
+// a configuration struct instead of using the functional options pattern.  It panics when
+// the template files can't be parsed, use NewGeneratorWithConfigE to get an error instead.
 func NewGeneratorWithConfig(config GeneratorConfig) *Generator {
+	g, err := newGenerator(config)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// NewGeneratorE is like NewGenerator, but returns an error instead of panicking when the template
+// files can't be parsed, and when the configuration isn't valid according to GeneratorConfig.Validate.
+func NewGeneratorE(options ...Option) (*Generator, error) {
+	cfg := NewGeneratorConfig()
+
+	// Apply all options
+	for _, option := range options {
+		option(cfg)
+	}
+
+	return NewGeneratorWithConfigE(*cfg)
+}
+
+// NewGeneratorWithConfigE is like NewGeneratorWithConfig, but returns an error instead of panicking
+// when the template files can't be parsed, and when the configuration isn't valid according to
+// GeneratorConfig.Validate.
+func NewGeneratorWithConfigE(config GeneratorConfig) (*Generator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return newGenerator(config)
+}
+
+// newGenerator creates a Generator with the embedded templates and the template files of the config.
+func newGenerator(config GeneratorConfig) (*Generator, error) {
 	g := &Generator{
 		Version:           "-",
 		Revision:          "-",
 		BuildDate:         "-",
 		BuiltBy:           "-",
 		knownTemplates:    make(map[string]*template.Template),
-		t:                 template.New("generator"),
 		fileSet:           token.NewFileSet(),
 		userTemplateNames: make([]string, 0),
 		GeneratorConfig:   config,
 	}
 
+	g.addEmbeddedTemplates()
+	g.updateTemplates()
+
+	// Process template files if any were provided
+	// This must happen AFTER embedded templates are added and updated
+	if err := g.processUserTemplates(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// templateFuncs returns the functions available to the templates.
+func templateFuncs() template.FuncMap {
 	funcs := sprig.TxtFuncMap()
 
 	funcs["stringify"] = Stringify
@@ -94,23 +179,27 @@ func NewGeneratorWithConfig(config GeneratorConfig) *Generator {
 	funcs["offset"] = Offset
 	funcs["quote"] = strconv.Quote
 	funcs["directVal"] = DirectValue
-
-	g.t.Funcs(funcs)
-
-	g.addEmbeddedTemplates()
-	g.updateTemplates()
-
-	// Process template files if any were provided
-	// This must happen AFTER embedded templates are added and updated
-	g.processUserTemplates()
-
-	return g
+	funcs["lookup"] = buildLookup
+	funcs["stringIndex"] = buildStringIndex
+	funcs["parseKeys"] = parseEntries
+	funcs["foldGroups"] = foldGroups
+	funcs["ordinals"] = ordinals
+	funcs["fitsBitset"] = fitsBitset
+	funcs["namedValues"] = namedValues
+	funcs["matchParam"] = matchParam
+	funcs["bitSize"] = bitSize
+
+	return funcs
 }
 
 // processUserTemplates handles loading and processing user template files
-func (g *Generator) processUserTemplates() {
+func (g *Generator) processUserTemplates() error {
 	if len(g.TemplateFileNames) > 0 {
-		for _, ut := range template.Must(g.t.ParseFiles(g.TemplateFileNames...)).Templates() {
+		t, err := g.t.ParseFiles(g.TemplateFileNames...)
+		if err != nil {
+			return fmt.Errorf("failed parsing template files: %w", err)
+		}
+		for _, ut := range t.Templates() {
 			if _, ok := g.knownTemplates[ut.Name()]; !ok {
 				g.userTemplateNames = append(g.userTemplateNames, ut.Name())
 			}
@@ -118,10 +207,11 @@ func (g *Generator) processUserTemplates() {
 		sort.Strings(g.userTemplateNames)
 		g.updateTemplates()
 	}
+	return nil
 }
 
-func (g *Generator) anySQLEnabled() bool {
-	return g.SQL || g.SQLNullStr || g.SQLInt || g.SQLNullInt
+func (c GeneratorConfig) anySQLEnabled() bool {
+	return c.SQL || c.SQLNullStr || c.SQLInt || c.SQLNullInt
 }
 
 // ParseAliases is used to add aliases to replace during name sanitization.
@@ -152,14 +242,105 @@ func (g *Generator) GenerateFromFile(inputFile string) ([]byte, error) {
 	return g.Generate(f)
 }
 
+// GenerateFromSource generates the code for the source of a file, like GenerateFromFile, without
+// reading the file.  The go.mod of the module isn't read either, so the generated code targets
+// GoVersion, or the newest go version when it is empty.  The filename is only used in the positions
+// and errors.  The source is parsed with a token.FileSet of its own, so the generator doesn't grow
+// with every call.
+func (g *Generator) GenerateFromSource(filename string, src []byte) ([]byte, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", filename, err)
+	}
+	return g.generate([]*ast.File{f}, g.GoVersion, enumTemplateName, g.userTemplateNames)
+}
+
+// GenerateBenchmarkFromFile parses the input file and generates a test file with benchmarks
+// comparing the generated String and Parse methods against plain map lookups.
+func (g *Generator) GenerateBenchmarkFromFile(inputFile string) ([]byte, error) {
+	f, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+	}
+	return g.GenerateBenchmark(f)
+}
+
 // Generate does the heavy lifting for the code generation starting from the parsed AST file.
 func (g *Generator) Generate(f *ast.File) ([]byte, error) {
-	enums := g.inspect(f)
+	return g.generate([]*ast.File{f}, g.fileGoVersion(f), enumTemplateName, g.userTemplateNames)
+}
+
+// GenerateBenchmark generates a test file with benchmarks for the enums found in the parsed AST file.
+func (g *Generator) GenerateBenchmark(f *ast.File) ([]byte, error) {
+	return g.generate([]*ast.File{f}, g.fileGoVersion(f), benchmarkTemplateName, nil)
+}
+
+// GenerateTestsFromFile parses the input file and generates a test file with round trip tests and
+// fuzz targets for the features that are enabled.
+func (g *Generator) GenerateTestsFromFile(inputFile string) ([]byte, error) {
+	f, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+	}
+	return g.GenerateTests(f)
+}
+
+// GenerateTests generates a test file with round trip tests and fuzz targets for the enums found in the
+// parsed AST file.
+func (g *Generator) GenerateTests(f *ast.File) ([]byte, error) {
+	return g.generate([]*ast.File{f}, g.fileGoVersion(f), testTemplateName, nil)
+}
+
+// enumTemplateName returns the name of the built in template used to generate the enum.
+func enumTemplateName(enum *Enum) string {
+	if enum.Type == "string" {
+		return "enum_string"
+	}
+	return "enum"
+}
+
+// benchmarkTemplateName returns the name of the template used to generate the benchmarks of the enum.
+func benchmarkTemplateName(*Enum) string {
+	return "benchmark"
+}
+
+// testTemplateName returns the name of the template used to generate the tests of the enum.
+func testTemplateName(*Enum) string {
+	return "enumtest"
+}
+
+// generate executes the header template and then, for each enum found in the files, the template
+// returned by enumTemplate followed by the extra templates.  The files must be of the same package,
+// and goVersion is the go version the code has to build with, or empty when it isn't known.
+func (g *Generator) generate(files []*ast.File, goVersion string, enumTemplate func(*Enum) string, extraTemplates []string) ([]byte, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	enums := make(map[string]*ast.TypeSpec)
+	for _, f := range files {
+		for name, ts := range g.inspect(f) {
+			enums[name] = ts
+		}
+	}
 	if len(enums) <= 0 {
 		return nil, nil
 	}
 
-	pkg := f.Name.Name
+	pkg := files[0].Name.Name
+
+	// Some of the generated code needs a newer go version than the module may have
+	iterators := g.Iterators && goVersionAtLeast(goVersion, iteratorVersion)
+	if g.Iterators && !iterators {
+		g.warn(&ConfigError{Options: []string{"Iterators"}, Reason: fmt.Sprintf("the iterators are skipped, as they need %s and the go version is %s", iteratorVersion, goVersion)})
+	}
+	enumMap := g.EnumMap && goVersionAtLeast(goVersion, genericsVersion)
+	if g.EnumMap && !enumMap {
+		g.warn(&ConfigError{Options: []string{"EnumMap"}, Reason: fmt.Sprintf("the enum maps are skipped, as they need %s and the go version is %s", genericsVersion, goVersion)})
+	}
+	matchGeneric := g.Match && goVersionAtLeast(goVersion, genericsVersion)
+	if g.Match && !matchGeneric {
+		g.warn(&ConfigError{Options: []string{"Match"}, Reason: fmt.Sprintf("the generic match functions are skipped, as they need %s and the go version is %s", genericsVersion, goVersion)})
+	}
 
 	vBuff := bytes.NewBuffer([]byte{})
 	err := g.t.ExecuteTemplate(vBuff, "header", map[string]any{
@@ -170,6 +351,8 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 		"builtBy":   g.BuiltBy,
 		"buildTags": g.BuildTags,
 		"jsonpkg":   g.JSONPkg,
+		"display":   g.Display,
+		"validator": g.Validator,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed writing header: %w", err)
@@ -191,6 +374,9 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 		if pErr != nil {
 			continue
 		}
+		for _, warning := range g.enumConfigErrors(enum) {
+			g.warn(warning)
+		}
 
 		created++
 
@@ -206,45 +392,85 @@ func (g *Generator) Generate(f *ast.File) ([]byte, error) {
 		// Determine if error variable is needed
 		generateError := generateParse || (enum.Type == "string" && g.SQLInt)
 
+		// Suggestions are part of the typed error, so they imply it
+		typedErrors := (g.TypedErrors || g.Suggest) && generateParse
+
+		// Normalized parsing ignores case and separators, which must not make two values ambiguous
+		var normalized *normalizedLookup
+		if (g.Normalize || g.Normalizer != "") && generateParse {
+			normalized, err = buildNormalized(*enum, g.ForceLower, g.ForceUpper, g.Normalizer)
+			if err != nil {
+				return nil, fmt.Errorf("failed normalizing enum: %q: %w", name, err)
+			}
+		}
+
+		// Open enums decode text through a lenient parse that preserves unknown values,
+		// and enums with a default can decode through a parse that falls back to it.
+		defaultValue := enum.DefaultValue()
+		fallback := g.DefaultFallback && defaultValue != nil && generateParse
+		decodeName := parseName
+		if fallback {
+			decodeName = "parseFallback"
+		} else if g.OpenEnum && generateParse {
+			decodeName = "parseOpen"
+		}
+
 		data := map[string]any{
-			"enum":          enum,
-			"name":          name,
-			"lowercase":     g.LowercaseLookup,
-			"nocase":        g.CaseInsensitive,
-			"nocomments":    g.NoComments,
-			"noIota":        g.NoIota,
-			"marshal":       g.Marshal,
-			"sql":           g.SQL,
-			"sqlint":        g.SQLInt,
-			"flag":          g.Flag,
-			"names":         g.Names,
-			"ptr":           g.Ptr,
-			"values":        g.Values,
-			"anySQLEnabled": g.anySQLEnabled(),
-			"sqlnullint":    g.SQLNullInt,
-			"sqlnullstr":    g.SQLNullStr,
-			"mustparse":     g.MustParse,
-			"forcelower":    g.ForceLower,
-			"forceupper":    g.ForceUpper,
-			"noparse":       g.NoParse,
+			"enum":           enum,
+			"name":           name,
+			"lowercase":      g.LowercaseLookup,
+			"nocase":         g.CaseInsensitive,
+			"nocomments":     g.NoComments,
+			"noIota":         g.NoIota,
+			"marshal":        g.Marshal,
+			"sql":            g.SQL,
+			"sqlint":         g.SQLInt,
+			"flag":           g.Flag,
+			"names":          g.Names,
+			"ptr":            g.Ptr,
+			"values":         g.Values,
+			"anySQLEnabled":  g.anySQLEnabled(),
+			"sqlnullint":     g.SQLNullInt,
+			"sqlnullstr":     g.SQLNullStr,
+			"mustparse":      g.MustParse,
+			"forcelower":     g.ForceLower,
+			"forceupper":     g.ForceUpper,
+			"noparse":        g.NoParse,
+			"open":           g.OpenEnum,
+			"fast":           g.FastLookup,
+			"default":        defaultValue,
+			"fallback":       fallback,
+			"typedErrors":    typedErrors,
+			"suggest":        g.Suggest && typedErrors,
+			"normalized":     normalized,
+			"normalizer":     g.Normalizer,
+			"ordinal":        g.Ordinal,
+			"ordinalWrap":    g.OrdinalWrap,
+			"iterators":      iterators,
+			"set":            g.Set,
+			"enumMap":        enumMap,
+			"match":          g.Match,
+			"matchGeneric":   matchGeneric,
+			"display":        g.Display,
+			"fuzz":           goVersionAtLeast(goVersion, fuzzVersion),
+			"validator":      g.Validator,
+			"validateMethod": g.ValidateMethod,
+			"guard":          g.Guard,
 			// Computed values for cleaner templates
 			"generateParse": generateParse,
 			"parseIsPublic": parseIsPublic,
 			"parseName":     parseName,
+			"decodeName":    decodeName,
+			"decodeBytes":   decodeName == parseName,
 			"generateError": generateError,
 		}
 
-		templateName := "enum"
-		if enum.Type == "string" {
-			templateName = "enum_string"
-		}
-
-		err = g.t.ExecuteTemplate(vBuff, templateName, data)
+		err = g.t.ExecuteTemplate(vBuff, enumTemplate(enum), data)
 		if err != nil {
 			return vBuff.Bytes(), fmt.Errorf("failed writing enum data for enum: %q: %w", name, err)
 		}
 
-		for _, userTemplateName := range g.userTemplateNames {
+		for _, userTemplateName := range extraTemplates {
 			err = g.t.ExecuteTemplate(vBuff, userTemplateName, data)
 			if err != nil {
 				return vBuff.Bytes(), fmt.Errorf("failed writing enum data for enum: %q, template: %v: %w", name, userTemplateName, err)
@@ -287,7 +513,7 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 	enum := &Enum{}
 
 	enum.Name = ts.Name.Name
-	enum.Type = fmt.Sprintf("%s", ts.Type)
+	enum.Type = g.underlyingType(ts)
 	if !g.NoPrefix {
 		enum.Prefix = ts.Name.Name
 	}
@@ -302,6 +528,7 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 	if enumDecl == "" {
 		return nil, errors.New("failed parsing enum")
 	}
+	enum.Hash = declarationHash(enum.Name, fmt.Sprintf("%s", ts.Type), enumDecl)
 
 	values := strings.Split(strings.TrimSuffix(strings.TrimPrefix(enumDecl, `ENUM(`), `)`), `,`)
 	var (
@@ -326,6 +553,14 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 			value = value[:commentStartIndex]
 		}
 
+		// Pull out the default marker so it doesn't end up in the name or value.  It has to come last,
+		// so a quoted value can contain it.
+		isDefault := false
+		if trimmed := strings.TrimSpace(value); strings.HasSuffix(trimmed, defaultMarker) {
+			value = strings.TrimSuffix(trimmed, defaultMarker)
+			isDefault = true
+		}
+
 		// Make sure to leave out any empty parts
 		if value != "" {
 			rawName := value
@@ -352,7 +587,7 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 						newData, err := strconv.ParseUint(dataVal, 0, 64)
 						if err != nil {
 							err = fmt.Errorf("failed parsing the data part of enum value '%s': %w", value, err)
-							fmt.Println(err)
+							fmt.Fprintln(os.Stderr, err)
 							return nil, err
 						}
 						data = newData
@@ -360,14 +595,14 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 						newData, err := strconv.ParseInt(dataVal, 0, 64)
 						if err != nil {
 							err = fmt.Errorf("failed parsing the data part of enum value '%s': %w", value, err)
-							fmt.Println(err)
+							fmt.Fprintln(os.Stderr, err)
 							return nil, err
 						}
 						data = newData
 					}
 				} else {
 					rawName = strings.TrimSuffix(rawName, `=`)
-					fmt.Printf("Ignoring enum with '=' but no value after: %s\n", rawName)
+					fmt.Fprintf(os.Stderr, "Ignoring enum with '=' but no value after: %s\n", rawName)
 				}
 			}
 
@@ -385,7 +620,20 @@ func (g *Generator) parseEnum(ts *ast.TypeSpec) (*Enum, error) {
 				}
 			}
 
-			ev := EnumValue{Name: name, RawName: rawName, PrefixedName: prefixedName, ValueStr: valueStr, ValueInt: data, Comment: comment}
+			if isDefault {
+				if name == skipHolder {
+					err := fmt.Errorf("skipped value can not be the default for enum '%s'", enum.Name)
+					fmt.Fprintln(os.Stderr, err)
+					return nil, err
+				}
+				if def := enum.DefaultValue(); def != nil {
+					err := fmt.Errorf("enum '%s' has more than one default value: '%s' and '%s'", enum.Name, def.RawName, rawName)
+					fmt.Fprintln(os.Stderr, err)
+					return nil, err
+				}
+			}
+
+			ev := EnumValue{Name: name, RawName: rawName, PrefixedName: prefixedName, ValueStr: valueStr, ValueInt: data, Comment: comment, IsDefault: isDefault}
 			enum.Values = append(enum.Values, ev)
 			data = increment(data)
 		}
@@ -535,7 +783,7 @@ func getEnumDeclFromComments(comments []*ast.Comment) string {
 	}
 
 	if enumParamLevel > 0 {
-		fmt.Println("ENUM Parse error, there is a dangling '(' in your comment.")
+		fmt.Fprintln(os.Stderr, "ENUM Parse error, there is a dangling '(' in your comment.")
 		return ""
 	}
 
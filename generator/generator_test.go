@@ -1,15 +1,26 @@
 package generator
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"go/ast"
+	"go/importer"
 	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/bradleyjkemp/cupaloy/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
 )
 
 const (
@@ -775,7 +786,7 @@ type Number int
 
 	// Should contain marshal methods that use the unexported parse
 	assert.Contains(t, outputStr, "func (x *Number) UnmarshalText(")
-	assert.Contains(t, outputStr, "parseNumber(name)")
+	assert.Contains(t, outputStr, "parseNumberBytes(text)")
 }
 
 // TestNoParseWithSQLCreatesUnexported tests that NoParse with SQL creates unexported parse
@@ -923,7 +934,7 @@ type Greek string
 
 	// Should contain marshal methods
 	assert.Contains(t, outputStr, "func (x *Greek) UnmarshalText(")
-	assert.Contains(t, outputStr, "parseGreek(string(text))")
+	assert.Contains(t, outputStr, "parseGreekBytes(text)")
 }
 
 // TestNoParseOmitsErrorVariable tests that NoParse without dependent features omits ErrInvalidXXX
@@ -1042,3 +1053,1502 @@ func TestStringSingleQuotedWithWhitespaceOnly(t *testing.T) {
 	assert.Nil(t, err, "Error generating formatted code")
 	assert.Contains(t, string(output), "CharEmpty Char = \" \"")
 }
+
+// TestOpenEnumWithIntEnum tests that the open option preserves unknown numeric values
+func TestOpenEnumWithIntEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number int
+`
+	g := NewGenerator(WithOpenEnum(), WithMarshal(), WithSQLDriver())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func (x Number) IsKnown() bool")
+	assert.Contains(t, outputStr, "func parseOpenNumber(name string) (Number, error)")
+	assert.Contains(t, outputStr, "strconv.ParseInt(name, 10, strconv.IntSize)")
+	assert.Contains(t, outputStr, "tmp, err := parseOpenNumber(string(text))")
+	assert.Contains(t, outputStr, "*x, err = parseOpenNumber(v)")
+	assert.Contains(t, outputStr, "return strconv.AppendInt(nil, int64(x), 10), nil")
+	assert.Contains(t, outputStr, "return strconv.FormatInt(int64(x), 10), nil")
+}
+
+// TestOpenEnumWithUintEnum tests that unsigned open enums parse unknown values as unsigned
+func TestOpenEnumWithUintEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number uint8
+`
+	g := NewGenerator(WithOpenEnum(), WithMarshal())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "strconv.ParseUint(name, 10, 8)")
+	assert.Contains(t, outputStr, "return strconv.AppendUint(nil, uint64(x), 10), nil")
+}
+
+// TestOpenEnumOutOfRange tests that an unknown number that doesn't fit the type of an open enum is
+// rejected, rather than wrapping around to another value
+func TestOpenEnumOutOfRange(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds and runs the generated code")
+	}
+	input := `package main
+
+// ENUM(a=-3, b)
+type Small int8
+
+// ENUM(a, b)
+type Tiny uint8
+`
+	program := `package main
+
+import "fmt"
+
+func main() {
+	for _, text := range []string{"253", "300", "-129", "127"} {
+		var x Small
+		err := x.UnmarshalText([]byte(text))
+		fmt.Println(text, int(x), x.IsKnown(), err != nil)
+	}
+	for _, text := range []string{"256", "255"} {
+		var x Tiny
+		err := x.UnmarshalText([]byte(text))
+		fmt.Println(text, int(x), x.IsKnown(), err != nil)
+	}
+}
+`
+	g := NewGenerator(WithOpenEnum(), WithMarshal())
+	output, err := g.GenerateFromSource("small.go", []byte(input))
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go.mod":        "module test\n\ngo 1.22\n",
+		"small.go":      input,
+		"small_enum.go": string(output),
+		"main.go":       program,
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644))
+	}
+	cmd := exec.Command("go", "run", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	// The numbers that don't fit are errors that leave the zero value, the others are kept as unknown values
+	assert.Equal(t, "253 0 false true\n300 0 false true\n-129 0 false true\n127 127 false false\n"+
+		"256 0 true true\n255 255 false false\n", string(out))
+}
+
+// TestOpenEnumWithStringEnum tests that the open option preserves unknown raw strings
+func TestOpenEnumWithStringEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(alpha, beta, gamma)
+type Greek string
+`
+	g := NewGenerator(WithOpenEnum(), WithMarshal(), WithSQLDriver(), WithFlag())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func (x Greek) IsKnown() bool")
+	assert.Contains(t, outputStr, "func parseOpenGreek(name string) (Greek, error)")
+	assert.Contains(t, outputStr, "return Greek(name), nil")
+	assert.Contains(t, outputStr, "tmp, err := parseOpenGreek(string(text))")
+	assert.Contains(t, outputStr, "*x, err = parseOpenGreek(v)")
+
+	// Flags stay strict so bad command line input is still rejected
+	assert.Contains(t, outputStr, "v, err := ParseGreek(val)")
+}
+
+// TestOpenEnumWithNoParse tests that open enums without any decoding don't generate the lenient parse
+func TestOpenEnumWithNoParse(t *testing.T) {
+	input := `package test
+
+// ENUM(alpha, beta, gamma)
+type Greek string
+`
+	g := NewGenerator(WithOpenEnum(), WithNoParse())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func (x Greek) IsKnown() bool")
+	assert.NotContains(t, outputStr, "parseOpenGreek")
+}
+
+// TestDefaultValueMarker tests that a value marked as default generates the default helpers
+func TestDefaultValueMarker(t *testing.T) {
+	input := `package test
+
+// ENUM(unknown [default], one, two = 5 // Two is special
+// three)
+type Number int
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "NumberUnknown Number = iota")
+	assert.Contains(t, outputStr, "NumberTwo Number = iota + 3")
+	assert.Contains(t, outputStr, "func NumberDefault() Number {\n\treturn NumberUnknown\n}")
+	assert.Contains(t, outputStr, "func ParseNumberOrDefault(name string) Number {")
+	assert.NotContains(t, outputStr, "[default]")
+	assert.NotContains(t, outputStr, "NumberFallbackHook")
+}
+
+// TestDefaultValueMarkerErrors tests that invalid default markers are rejected
+func TestDefaultValueMarkerErrors(t *testing.T) {
+	tests := map[string]string{
+		"multiple": `package test
+// ENUM(one [default], two [default])
+type Number int
+`,
+		"skipped": `package test
+// ENUM(_ [default], one, two)
+type Number int
+`,
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator()
+			f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			enums := g.inspect(f)
+			_, err = g.parseEnum(enums["Number"])
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestDefaultValueMarkerInQuotedValue tests that the default marker is only recognized after the value
+func TestDefaultValueMarkerInQuotedValue(t *testing.T) {
+	input := `package test
+
+// ENUM(a="x[default]", b="y" [default])
+type Letter string
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums, err := g.Inspect(f)
+	require.NoError(t, err)
+	require.Len(t, enums, 1)
+	require.Len(t, enums[0].Values, 2)
+
+	a, b := enums[0].Values[0], enums[0].Values[1]
+	assert.Equal(t, "x[default]", a.ValueStr)
+	assert.False(t, a.IsDefault)
+	assert.Equal(t, "y", b.ValueStr)
+	assert.True(t, b.IsDefault)
+}
+
+// TestDefaultFallbackWithStringEnum tests that decoding falls back to the default value
+func TestDefaultFallbackWithStringEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(alpha, beta, gamma [default])
+type Greek string
+
+// ENUM(one, two)
+type Number int
+`
+	g := NewGenerator(WithDefaultFallback(), WithMarshal(), WithSQLDriver(), WithFlag())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "var GreekFallbackHook func(input string, err error)")
+	assert.Contains(t, outputStr, "func parseFallbackGreek(name string) (Greek, error) {")
+	assert.Contains(t, outputStr, "tmp, err := parseFallbackGreek(string(text))")
+	assert.Contains(t, outputStr, "*x, err = parseFallbackGreek(v)")
+	assert.Contains(t, outputStr, "v, err := parseFallbackGreek(val)")
+	assert.Contains(t, outputStr, "*x = GreekGamma\n")
+
+	// Enums without a default keep returning errors
+	assert.NotContains(t, outputStr, "parseFallbackNumber")
+	assert.Contains(t, outputStr, "v, err := ParseNumber(val)")
+}
+
+// TestFastLookupWithIntEnum tests that fast lookups replace the map lookups in String, IsValid and Parse
+func TestFastLookupWithIntEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, _, four)
+type Dense int
+
+// ENUM(one=1, ten=10, Hundred=100)
+type Sparse uint
+`
+	g := NewGenerator(WithFastLookup(), WithLowercaseVariant())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "var _DenseIndex = [...]uint8{0, 3, 6, 6, 10}")
+	assert.Contains(t, outputStr, "i := uint64(x)\n\treturn _DenseName[_DenseIndex[i]:_DenseIndex[i+1]]")
+	assert.Contains(t, outputStr, "return i < uint64(len(_DenseIndex)-1) && _DenseIndex[i] != _DenseIndex[i+1]")
+	assert.Contains(t, outputStr, "func lookupDense(name string) (Dense, bool) {\n\tswitch name {")
+	assert.Contains(t, outputStr, "if x, ok := lookupDense(name); ok {")
+
+	assert.NotContains(t, outputStr, "_SparseIndex")
+	assert.Contains(t, outputStr, "\tcase SparseTen:\n\t\treturn _SparseName[3:6]")
+	assert.Contains(t, outputStr, "\tcase SparseOne, SparseTen, SparseHundred:\n\t\treturn true")
+
+	// Lowercase keys that are identical to the original are only added once
+	assert.Equal(t, 1, strings.Count(outputStr, `case "two":`))
+	assert.Contains(t, outputStr, `case "Hundred":`)
+	assert.Contains(t, outputStr, `case "hundred":`)
+}
+
+// TestFastLookupInt8Bounds tests that the dense index of an int8 enum at the bounds of the type compiles
+func TestFastLookupInt8Bounds(t *testing.T) {
+	// The values go from -128 to -1, a span of 128 that doesn't fit in an int8
+	values := []string{"first=-128"}
+	for i := 1; i < 64; i++ {
+		values = append(values, fmt.Sprintf("value%d", i))
+	}
+	values = append(values, "last=-1")
+	input := fmt.Sprintf("package test\n\n// ENUM(%s)\ntype Wide int8\n", strings.Join(values, ", "))
+
+	g := NewGenerator(WithFastLookup())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	outputStr := string(output)
+	assert.Contains(t, outputStr, "i := uint64(x) + 128\n\treturn _WideName[_WideIndex[i]:_WideIndex[i+1]]")
+	assert.Contains(t, outputStr, "return i < uint64(len(_WideIndex)-1) && _WideIndex[i] != _WideIndex[i+1]")
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for name, src := range map[string]string{"test.go": input, "test_enum.go": outputStr} {
+		parsed, err := parser.ParseFile(fset, name, src, 0)
+		require.NoError(t, err)
+		files = append(files, parsed)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("test", fset, files, nil)
+	assert.NoError(t, err)
+}
+
+// TestFastLookupPerfectHash tests that large enums get a perfect hash lookup
+func TestFastLookupPerfectHash(t *testing.T) {
+	values := make([]string, 0, 100)
+	for i := range 100 {
+		values = append(values, fmt.Sprintf("value%d", i))
+	}
+	input := fmt.Sprintf("package test\n\n// ENUM(%s)\ntype Big string\n", strings.Join(values, ", "))
+
+	g := NewGenerator(WithFastLookup())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+	assert.Contains(t, outputStr, "var _BigLookupSeeds = [...]uint32{")
+	assert.Contains(t, outputStr, "func mixBig(h, seed uint32) uint32 {")
+	assert.Contains(t, outputStr, "func lookupBig(name string) (Big, bool) {")
+
+	enums := g.inspect(f)
+	enum, err := g.parseEnum(enums["Big"])
+	require.NoError(t, err)
+
+	table := buildLookup(*enum, false, false, false)
+	require.True(t, table.IsHash())
+	require.Len(t, table.Slots, 100)
+	for _, entry := range table.Entries {
+		h := lookupHash(entry.Key)
+		seed := table.Seeds[lookupMix(h, 0)%uint32(len(table.Seeds))]
+		slot := lookupMix(h, seed) % uint32(len(table.Slots))
+		assert.Equal(t, entry, table.Slots[slot])
+	}
+}
+
+// TestGenerateBenchmark tests the generated benchmark file
+func TestGenerateBenchmark(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	g := NewGenerator(WithFastLookup(), WithNoParse())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.GenerateBenchmark(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, `import "testing"`)
+	assert.Contains(t, outputStr, "func BenchmarkNumberStringMap(b *testing.B) {")
+	assert.Contains(t, outputStr, "func BenchmarkNumberString(b *testing.B) {")
+	assert.Contains(t, outputStr, "func BenchmarkNumberParseMap(b *testing.B) {")
+	assert.NotContains(t, outputStr, "func BenchmarkNumberParse(b *testing.B) {")
+	assert.NotContains(t, outputStr, "func BenchmarkGreekStringMap(b *testing.B) {")
+	assert.Contains(t, outputStr, "func BenchmarkGreekParseMap(b *testing.B) {")
+}
+
+// TestGenerateTests tests that the generated test file covers the enabled features
+func TestGenerateTests(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	g := NewGenerator(WithMarshal(), WithSQLDriver(), WithMustParse())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.GenerateTests(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, `{"one", NumberOne},`)
+	assert.Contains(t, outputStr, `{"beta", GreekBeta},`)
+	assert.Contains(t, outputStr, "func TestNumberRoundTrip(t *testing.T) {")
+	assert.Contains(t, outputStr, "func FuzzParseGreek(f *testing.F) {")
+	assert.Contains(t, outputStr, "MustParseNumber(tc.name)")
+	assert.Contains(t, outputStr, "json.Marshal(tc.value)")
+	assert.Contains(t, outputStr, "scanned.Scan(v)")
+	assert.NotContains(t, outputStr, "flagged.Set(tc.name)")
+	assert.NotContains(t, outputStr, "tc.value.Ptr()")
+
+	g = NewGenerator(WithNoParse(), WithMarshal(), WithGoVersion("go1.17"))
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err = g.GenerateTests(f)
+	require.NoError(t, err)
+	outputStr = string(output)
+	assert.Contains(t, outputStr, "parseNumber(tc.name)")
+	assert.NotContains(t, outputStr, "func Fuzz")
+}
+
+// TestGuard tests the compile time guard and the declaration hash
+func TestGuard(t *testing.T) {
+	input := `package test
+
+// ENUM(neg = -2, zero, _, two)
+type Number int
+
+// ENUM(alpha, beta = b)
+type Greek string
+`
+	g := NewGenerator(WithGuard())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "_ = x[NumberNeg-(-2)]")
+	assert.Contains(t, outputStr, "_ = x[NumberZero-(-1)]")
+	assert.Contains(t, outputStr, "_ = x[NumberTwo-1]")
+	assert.Contains(t, outputStr, `_ = map[bool]struct{}{false: {}, GreekBeta == "b": {}}`)
+	assert.Contains(t, outputStr, "const _NumberDeclHash = ")
+
+	generated, err := parser.ParseFile(g.fileSet, "test_enum.go", output, parser.ParseComments)
+	require.NoError(t, err)
+	stale, err := g.StaleEnums(f, generated)
+	require.NoError(t, err)
+	assert.Empty(t, stale)
+
+	changed := strings.Replace(input, "beta = b", "beta = c", 1)
+	f, err = parser.ParseFile(g.fileSet, "test.go", changed, parser.ParseComments)
+	require.NoError(t, err)
+	stale, err = g.StaleEnums(f, generated)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Greek"}, stale)
+}
+
+// TestCaseInsensitiveFold tests that case insensitive parsing folds the case instead of lowercasing the input
+func TestCaseInsensitiveFold(t *testing.T) {
+	input := `package test
+
+// ENUM(Cat, Dog, Fish, _, Mouse)
+type Animal int
+
+// ENUM(up, Down, UP)
+type Direction string
+`
+	g := NewGenerator(WithCaseInsensitiveParse(), WithMarshal(), WithSQLDriver())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.NotContains(t, outputStr, "strings.ToLower")
+	assert.Contains(t, outputStr, "func foldAnimal(name string) (Animal, bool) {\n\tswitch len(name) {\n\tcase 3:")
+	assert.Contains(t, outputStr, "\tcase 5:\n\t\tif strings.EqualFold(name, \"Mouse\") {\n\t\t\treturn AnimalMouse, true")
+	assert.Contains(t, outputStr, "if x, ok := foldAnimal(name); ok {")
+	assert.Contains(t, outputStr, "func ParseAnimalBytes(name []byte) (Animal, error) {\n\tif x, ok := _AnimalValue[string(name)]; ok {")
+	assert.Contains(t, outputStr, "if x, ok := foldAnimal(string(name)); ok {")
+	assert.Contains(t, outputStr, "tmp, err := ParseAnimalBytes(text)")
+	assert.Contains(t, outputStr, "*x, err = ParseAnimalBytes(v)")
+
+	// Values that only differ by case keep the first one declared
+	assert.Contains(t, outputStr, "if strings.EqualFold(name, \"up\") {\n\t\t\treturn DirectionUp, true")
+	assert.Equal(t, 1, strings.Count(outputStr, "strings.EqualFold(name, \"up\")"))
+	assert.NotContains(t, outputStr, "strings.EqualFold(name, \"UP\")")
+	assert.Contains(t, outputStr, "\"UP\":   DirectionUP,")
+}
+
+// TestParseBytesWithOpenEnum tests that decoders that aren't the plain parse keep using strings
+func TestParseBytesWithOpenEnum(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two)
+type Number int
+`
+	g := NewGenerator(WithMarshal(), WithOpenEnum())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func ParseNumberBytes(name []byte) (Number, error) {")
+	assert.Contains(t, outputStr, "tmp, err := parseOpenNumber(string(text))")
+}
+
+// TestTypedErrors tests that the typed parse error is generated, with and without suggestions
+func TestTypedErrors(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	tests := map[string]struct {
+		options []Option
+		suggest bool
+	}{
+		"typed":   {options: []Option{WithTypedErrors()}},
+		"suggest": {options: []Option{WithSuggestions()}, suggest: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator(tc.options...)
+			f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			require.NoError(t, err)
+			require.NotNil(t, output)
+
+			outputStr := string(output)
+
+			for _, enum := range []string{"Number", "Greek"} {
+				// The names are needed for the error even when --names is off
+				assert.Contains(t, outputStr, "var _"+enum+"Names = []string{")
+				assert.NotContains(t, outputStr, "func "+enum+"Names() []string {")
+
+				assert.Contains(t, outputStr, "type Invalid"+enum+"Error struct {")
+				assert.Contains(t, outputStr, "func (e *Invalid"+enum+"Error) Unwrap() error {\n\treturn ErrInvalid"+enum)
+				assert.Contains(t, outputStr, "invalid"+enum+"Error(name)\n}")
+				assert.Contains(t, outputStr, "invalid"+enum+"Error(string(name))\n}")
+				assert.NotContains(t, outputStr, "fmt.Errorf(\"%s is %w\", name, ErrInvalid"+enum+")")
+				if tc.suggest {
+					assert.Contains(t, outputStr, "Suggestion: suggest"+enum+"(input),")
+					assert.Contains(t, outputStr, "func suggest"+enum+"(input string) string {")
+				} else {
+					assert.NotContains(t, outputStr, "Suggestion")
+					assert.NotContains(t, outputStr, "func suggest"+enum)
+				}
+			}
+		})
+	}
+}
+
+// TestTypedErrorsWithoutParse tests that no error type is generated when there is no parse method
+func TestTypedErrorsWithoutParse(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, three)
+type Number int
+`
+	g := NewGenerator(WithSuggestions(), WithNoParse())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.NotContains(t, outputStr, "InvalidNumberError")
+	assert.NotContains(t, outputStr, "_NumberNames")
+}
+
+// TestNormalizedParse tests that normalized parsing is generated with the keys normalized at generation time
+func TestNormalizedParse(t *testing.T) {
+	input := `package test
+
+// ENUM(pending, in-progress, On_Hold)
+type Status int
+
+// ENUM(us-east, eu.west)
+type Region string
+`
+	g := NewGenerator(WithNormalize())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "\"inprogress\": StatusInProgress,")
+	assert.Contains(t, outputStr, "\"onhold\":     StatusOnHold,")
+	assert.Contains(t, outputStr, "func normalizedStatus(name string) (Status, bool) {\n\tvar buf [10]byte")
+	assert.Contains(t, outputStr, "if x, ok := normalizedStatus(name); ok {")
+	assert.Contains(t, outputStr, "if x, ok := normalizedStatus(string(name)); ok {")
+
+	assert.Contains(t, outputStr, "\"useast\": RegionUsEast,")
+	assert.Contains(t, outputStr, "func normalizedRegion(name string) (Region, bool) {\n\tvar buf [6]byte")
+	assert.Contains(t, outputStr, "\t\t\treturn \"\", false")
+}
+
+// TestNormalizedParseCollision tests that values which normalize to the same key are an error
+func TestNormalizedParseCollision(t *testing.T) {
+	input := `package test
+
+// ENUM(up, down, UP)
+type Direction int
+
+// ENUM(active=in-progress, running=in_progress)
+type Status string
+`
+	g := NewGenerator(WithNormalize())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	_, err = g.Generate(f)
+	require.Error(t, err)
+	assert.EqualError(t, err, `failed normalizing enum: "Direction": "up" and "UP" both normalize to "up"`)
+
+	input = strings.Replace(input, "UP)", "sideways)", 1)
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+	_, err = g.Generate(f)
+	require.Error(t, err)
+	assert.EqualError(t, err, `failed normalizing enum: "Status": "in-progress" and "in_progress" both normalize to "inprogress"`)
+
+	// Without normalizing, the same enum is fine
+	g = NewGenerator()
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+	_, err = g.Generate(f)
+	require.NoError(t, err)
+}
+
+// TestNormalizedParseCustomNormalizer tests that a custom normalizer is applied to the names and the input
+func TestNormalizedParseCustomNormalizer(t *testing.T) {
+	input := `package test
+
+// ENUM(active=in-progress, running=in_progress)
+type Status string
+`
+	g := NewGenerator(WithNormalizer("statusKey"))
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "statusKey(\"in-progress\"): StatusActive,")
+	assert.Contains(t, outputStr, "statusKey(\"in_progress\"): StatusRunning,")
+	assert.Contains(t, outputStr, "x, ok := _StatusNormalized[statusKey(name)]")
+	assert.NotContains(t, outputStr, "var buf")
+}
+
+// TestOrdinal tests the ordinal methods and constants for int and string enums
+func TestOrdinal(t *testing.T) {
+	input := `package test
+
+// ENUM(low=1, _, high=5)
+type Level int
+
+// ENUM(draft, review)
+type Stage string
+`
+	tests := map[string]struct {
+		options []Option
+		wrap    bool
+	}{
+		"ordinal": {options: []Option{WithOrdinal()}},
+		"wrap":    {options: []Option{WithOrdinalWrap()}, wrap: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator(tc.options...)
+			f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			require.NoError(t, err)
+			require.NotNil(t, output)
+
+			outputStr := string(output)
+
+			assert.Contains(t, outputStr, "LevelMin = LevelLow")
+			assert.Contains(t, outputStr, "LevelMax = LevelHigh")
+			assert.Contains(t, outputStr, "LevelCount = 2")
+			assert.Contains(t, outputStr, "\tcase LevelHigh:\n\t\treturn 1\n")
+			assert.Contains(t, outputStr, "StageCount = 2")
+			assert.Contains(t, outputStr, "var _StageOrdered = [StageCount]Stage{\n\tStageDraft,\n\tStageReview,\n}")
+			assert.Contains(t, outputStr, "func (x Stage) Compare(other Stage) int {")
+
+			if tc.wrap {
+				assert.Contains(t, outputStr, "return _LevelOrdered[(i+1)%LevelCount], true")
+				assert.Contains(t, outputStr, "return _LevelOrdered[(i+LevelCount-1)%LevelCount], true")
+			} else {
+				assert.Contains(t, outputStr, "if i < 0 || i == LevelCount-1 {")
+				assert.Contains(t, outputStr, "return _LevelOrdered[i+1], true")
+				assert.Contains(t, outputStr, "if i < 1 {")
+				assert.Contains(t, outputStr, "return _LevelOrdered[i-1], true")
+			}
+		})
+	}
+}
+
+// TestIterators tests that the iterator functions are only generated for new enough go versions
+func TestIterators(t *testing.T) {
+	input := `package test
+
+// ENUM(one, _, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	tests := map[string]struct {
+		goVersion string
+		generated bool
+	}{
+		"go.mod":    {generated: true},
+		"go1.23":    {goVersion: "1.23", generated: true},
+		"go1.24.2":  {goVersion: "go1.24.2", generated: true},
+		"go1.22":    {goVersion: "1.22"},
+		"go1.21rc1": {goVersion: "1.21rc1"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := NewGenerator(WithIterators(), WithGoVersion(tc.goVersion))
+			f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+			require.NoError(t, err)
+
+			output, err := g.Generate(f)
+			require.NoError(t, err)
+			require.NotNil(t, output)
+
+			outputStr := string(output)
+
+			if !tc.generated {
+				assert.NotContains(t, outputStr, "iter")
+				return
+			}
+			assert.Contains(t, outputStr, "\t\"iter\"\n")
+			assert.Contains(t, outputStr, "func NumberAll() iter.Seq[Number] {")
+			assert.Contains(t, outputStr, "for _, x := range [...]Number{\n\t\t\tNumberOne,\n\t\t\tNumberThree,\n\t\t} {")
+			assert.Contains(t, outputStr, "func NumberEntries() iter.Seq2[string, Number] {")
+			assert.Contains(t, outputStr, "{_NumberName[3:8], NumberThree},")
+			assert.Contains(t, outputStr, "func GreekAll() iter.Seq[Greek] {")
+			assert.Contains(t, outputStr, "{\"beta\", GreekBeta},")
+		})
+	}
+}
+
+// TestModuleGoVersion tests reading the go directive from the closest go.mod
+func TestModuleGoVersion(t *testing.T) {
+	dir := t.TempDir()
+	nested := filepath.Join(dir, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/old\n\ngo 1.21\n"), 0o644))
+
+	assert.Equal(t, "1.21", moduleGoVersion(nested))
+
+	g := NewGenerator(WithIterators())
+	version := g.targetGoVersion(filepath.Join(nested, "enum.go"))
+	assert.Equal(t, "1.21", version)
+	assert.True(t, goVersionAtLeast(version, genericsVersion))
+	assert.False(t, goVersionAtLeast(version, iteratorVersion))
+
+	// The configured version wins over the go.mod
+	g = NewGenerator(WithGoVersion("go1.23.1"))
+	assert.Equal(t, "go1.23.1", g.targetGoVersion(filepath.Join(nested, "enum.go")))
+}
+
+// TestSet tests that the set type is bitset backed only when every value fits in a uint64
+func TestSet(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two, _, four)
+type Small int
+
+// ENUM(low=1, high=64)
+type Large uint
+
+// ENUM(neg=-1, zero)
+type Signed int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	g := NewGenerator(WithSet(), WithMarshal(), WithSQLDriver())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "type SmallSet struct {\n\tbits uint64\n}")
+	assert.Contains(t, outputStr, "return bits.OnesCount64(s.bits)")
+	assert.Contains(t, outputStr, "type LargeSet struct {\n\tm map[Large]struct{}\n}")
+	assert.Contains(t, outputStr, "type SignedSet struct {\n\tm map[Signed]struct{}\n}")
+	assert.Contains(t, outputStr, "type GreekSet struct {\n\tm map[Greek]struct{}\n}")
+	assert.Contains(t, outputStr, "var _SmallSetOrder = [...]Small{\n\tSmallOne,\n\tSmallTwo,\n\tSmallFour,\n}")
+
+	for _, enum := range []string{"Small", "Large", "Signed", "Greek"} {
+		assert.Contains(t, outputStr, "func Parse"+enum+"Set(list string) ("+enum+"Set, error) {")
+		assert.Contains(t, outputStr, "func (s "+enum+"Set) MarshalJSON() ([]byte, error) {")
+		assert.Contains(t, outputStr, "func (s *"+enum+"Set) Scan(value interface{}) error {")
+	}
+	// Iterators are only added when asked for
+	assert.NotContains(t, outputStr, "iter.Seq")
+}
+
+// TestSetWithoutMarshal tests that the set has no marshalling or parsing when nothing needs it
+func TestSetWithoutMarshal(t *testing.T) {
+	input := `package test
+
+// ENUM(one, two)
+type Number int
+`
+	g := NewGenerator(WithSet(), WithNoParse())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "type NumberSet struct {")
+	assert.Contains(t, outputStr, "func (s NumberSet) String() string {")
+	assert.NotContains(t, outputStr, "NumberSet(list string)")
+	assert.NotContains(t, outputStr, "func (s NumberSet) MarshalText")
+	assert.NotContains(t, outputStr, "func (s *NumberSet) Scan")
+}
+
+// TestEnumMap tests the array backed map, which needs generics
+func TestEnumMap(t *testing.T) {
+	input := `package test
+
+// ENUM(one, _, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	g := NewGenerator(WithEnumMap(), WithMarshal())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "type NumberMap[V any] struct {\n\tvalues [2]V\n}")
+	assert.Contains(t, outputStr, "var _NumberMapKeys = [...]Number{\n\tNumberOne,\n\tNumberThree,\n}")
+	assert.Contains(t, outputStr, "func mapIndexNumber(x Number) int {")
+	assert.Contains(t, outputStr, "func (m *NumberMap[V]) Get(x Number) V {\n\tif i := mapIndexNumber(x); i >= 0 {")
+	assert.Contains(t, outputStr, "type GreekMap[V any] struct {\n\tvalues [2]V\n}")
+	assert.Contains(t, outputStr, "func (m GreekMap[V]) MarshalJSON() ([]byte, error) {")
+	assert.Contains(t, outputStr, "x, err := ParseGreek(name)")
+	assert.NotContains(t, outputStr, "iter.Seq2")
+
+	// The ordinal is reused when it is generated
+	g = NewGenerator(WithEnumMap(), WithOrdinal())
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err = g.Generate(f)
+	require.NoError(t, err)
+
+	outputStr = string(output)
+	assert.Contains(t, outputStr, "if i := x.Ordinal(); i >= 0 {")
+	assert.NotContains(t, outputStr, "mapIndexNumber")
+	assert.NotContains(t, outputStr, "MarshalJSON")
+
+	// Modules older than go1.18 can't use generics
+	g = NewGenerator(WithEnumMap(), WithGoVersion("1.17"))
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err = g.Generate(f)
+	require.NoError(t, err)
+	assert.NotContains(t, string(output), "NumberMap[V any]")
+}
+
+// TestMatch tests the Match method and the generic Match function skip placeholders
+func TestMatch(t *testing.T) {
+	input := `package test
+
+// ENUM(one, _, three)
+type Number int
+
+// ENUM(not_found, ok)
+type Status string
+`
+	g := NewGenerator(WithMatch())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "func (x Number) Match(\n\tonOne func(),\n\tonThree func(),\n) {")
+	assert.Contains(t, outputStr, "\tcase NumberThree:\n\t\tonThree()\n")
+	assert.Contains(t, outputStr, "func MatchNumber[T any](\n\tx Number,\n\tonOne func() T,\n\tonThree func() T,\n) T {")
+	assert.Contains(t, outputStr, "func (x Status) Match(\n\tonNotFound func(),\n\tonOk func(),\n) {")
+	assert.Contains(t, outputStr, "\tcase StatusNotFound:\n\t\treturn onNotFound()\n")
+
+	// The generic function needs go1.18
+	g = NewGenerator(WithMatch(), WithGoVersion("1.17"))
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err = g.Generate(f)
+	require.NoError(t, err)
+
+	outputStr = string(output)
+	assert.Contains(t, outputStr, "func (x Number) Match(")
+	assert.NotContains(t, outputStr, "MatchNumber[T any]")
+}
+
+// TestMatchParamNames tests the Match parameters are named after the sanitized constants, so
+// names that aren't identifiers still compile
+func TestMatchParamNames(t *testing.T) {
+	input := `package test
+
+// ENUM(Toyota, Mercedes-Benz, 3d, in_transit)
+type Car int
+`
+	for _, opts := range [][]Option{
+		{WithMatch()},
+		{WithMatch(), WithoutSnakeToCamel()},
+		{WithMatch(), WithNoPrefix()},
+	} {
+		g := NewGenerator(opts...)
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "test.go", input, parser.ParseComments)
+		require.NoError(t, err)
+
+		output, err := g.GenerateFromSource("test.go", []byte(input))
+		require.NoError(t, err)
+
+		outputStr := string(output)
+		assert.Contains(t, outputStr, "\tonMercedesBenz func(),\n")
+		assert.Contains(t, outputStr, "\tonInTransit func() T,\n")
+
+		generated, err := parser.ParseFile(fset, "test_enum.go", output, 0)
+		require.NoError(t, err)
+		conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+		_, err = conf.Check("test", fset, []*ast.File{f, generated}, nil)
+		require.NoError(t, err)
+	}
+
+	g := NewGenerator(WithMatch())
+	output, err := g.GenerateFromSource("test.go", []byte(input))
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "func (x Car) Match(\n\tonToyota func(),\n\tonMercedesBenz func(),\n\ton3D func(),\n\tonInTransit func(),\n) {")
+	assert.Contains(t, string(output), "\tcase Car3D:\n\t\ton3D()\n")
+}
+
+// TestDisplay tests the Display method and its imports
+func TestDisplay(t *testing.T) {
+	input := `package test
+
+// ENUM(one, _, three)
+type Number int
+
+// ENUM(active=in-progress)
+type Status string
+`
+	g := NewGenerator(WithDisplay())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "\t\"golang.org/x/text/language\"\n\t\"golang.org/x/text/message\"\n")
+	assert.Contains(t, outputStr, "func (x Number) Display(tag language.Tag) string {")
+	assert.Contains(t, outputStr, `message.NewPrinter(tag).Sprintf(message.Key("Number."+name, strings.ReplaceAll(name, "%", "%%")))`)
+
+	msgs, err := g.DisplayMessages(f)
+	require.NoError(t, err)
+	assert.Equal(t, []DisplayMessage{
+		{Package: "test", Enum: "Number", Key: "Number.one", Name: "one"},
+		{Package: "test", Enum: "Number", Key: "Number.three", Name: "three"},
+		{Package: "test", Enum: "Status", Key: "Status.in-progress", Name: "in-progress"},
+	}, msgs)
+}
+
+// TestUpdateMessageFiles tests that updating the translation files keeps the translations
+func TestUpdateMessageFiles(t *testing.T) {
+	dir := t.TempDir()
+	en, fr := language.English, language.French
+
+	msgs := []DisplayMessage{
+		{Enum: "Weather", Key: "Weather.sun", Name: "sun"},
+		{Enum: "Weather", Key: "Weather.rain", Name: "rain", Comment: "A noun"},
+		{Enum: "Animal", Key: "Animal.cat", Name: "cat"},
+	}
+	written, err := UpdateMessageFiles(dir, en, []language.Tag{en, fr}, msgs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "en", MessagesFileName), filepath.Join(dir, "fr", MessagesFileName)}, written)
+
+	// Translate the french file, then drop a value and add another one
+	frFile := filepath.Join(dir, "fr", MessagesFileName)
+	raw, err := os.ReadFile(frFile)
+	require.NoError(t, err)
+	raw = bytes.Replace(raw, []byte("\"message\": \"rain\",\n            \"translation\": \"\""), []byte("\"message\": \"rain\",\n            \"translation\": \"pluie & neige\""), 1)
+	require.NoError(t, os.WriteFile(frFile, raw, 0o644))
+
+	_, err = UpdateMessageFiles(dir, en, []language.Tag{en, fr}, []DisplayMessage{
+		{Enum: "Weather", Key: "Weather.rain", Name: "rain", Comment: "A noun"},
+		{Enum: "Weather", Key: "Weather.fog", Name: "fog"},
+	})
+	require.NoError(t, err)
+
+	got, err := readMessages(frFile)
+	require.NoError(t, err)
+	var keys, translations []string
+	for _, m := range got.Messages {
+		keys = append(keys, m.Key)
+		translations = append(translations, m.Translation.Msg)
+	}
+	// The Animal messages were not part of the update, so they are kept
+	assert.Equal(t, []string{"Animal.cat", "Weather.rain", "Weather.fog"}, keys)
+	assert.Equal(t, []string{"", "pluie & neige", ""}, translations)
+	assert.Equal(t, "A noun", got.Messages[1].Comment)
+
+	got, err = readMessages(filepath.Join(dir, "en", MessagesFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "fog", got.Messages[2].Translation.Msg)
+
+	catalog, err := DisplayCatalog("test", dir, []language.Tag{fr})
+	require.NoError(t, err)
+	assert.Contains(t, string(catalog), "package test\n")
+	assert.Contains(t, string(catalog), `{"fr", "Weather.rain", "pluie & neige"},`)
+	assert.NotContains(t, string(catalog), "Weather.fog")
+}
+
+// TestValidation tests the validator registration and the Validate method
+func TestValidation(t *testing.T) {
+	input := `package test
+
+// ENUM(one, _, three)
+type Number int
+
+// ENUM(alpha, beta)
+type Greek string
+`
+	g := NewGenerator(WithValidator(), WithValidateMethod())
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.Generate(f)
+	require.NoError(t, err)
+	require.NotNil(t, output)
+
+	outputStr := string(output)
+
+	assert.Contains(t, outputStr, "\t\"github.com/go-playground/validator/v10\"\n")
+	assert.Contains(t, outputStr, "func RegisterNumberValidation(v *validator.Validate) {")
+	assert.Contains(t, outputStr, `v.RegisterValidation("number", func(fl validator.FieldLevel) bool {`)
+	assert.Contains(t, outputStr, "_, err := ParseNumber(fl.Field().String())")
+	assert.Contains(t, outputStr, `v.RegisterValidation("greek", func(fl validator.FieldLevel) bool {`)
+	assert.Contains(t, outputStr, "func (x Number) Validate() error {")
+	assert.Contains(t, outputStr, `return fmt.Errorf("%v is %w", x, ErrInvalidNumber)`)
+
+	// Without a parse method, only the enum type is accepted
+	g = NewGenerator(WithValidator(), WithValidateMethod(), WithNoParse())
+	f, err = parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err = g.Generate(f)
+	require.NoError(t, err)
+
+	outputStr = string(output)
+	assert.NotContains(t, outputStr, "reflect.String")
+	assert.Contains(t, outputStr, `return fmt.Errorf("%v is not a valid Number", x)`)
+}
+
+// TestLoadPackages tests that the enums of every package matching the patterns are found, with their
+// underlying types resolved through the type information
+func TestLoadPackages(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/pkgs\n\ngo 1.22\n",
+		"a.go": `package pkgs
+
+type name string
+
+// ENUM(north, south)
+type Direction name
+
+var _ = DirectionNorth
+`,
+		"a_enum.go":       "// Code generated by go-enum DO NOT EDIT.\n\npackage pkgs\n\n// ENUM(stale)\ntype Generated int\n",
+		"sub/b.go":        "package sub\n\n// ENUM(x, y)\ntype Axis int\n",
+		"sub/b_test.go":   "package sub\n\n// ENUM(one, two)\ntype TestOnly int\n",
+		"sub/c.go":        "package sub\n\ntype NotAnEnum int\n",
+		"sub/d_ignore.go": "//go:build ignore\n\npackage sub\n\n// ENUM(a, b)\ntype Ignored int\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	t.Chdir(dir)
+
+	g := NewGenerator()
+	loaded, err := g.LoadPackages("./...")
+	require.NoError(t, err)
+
+	var names []string
+	for _, file := range loaded {
+		rel, err := filepath.Rel(dir, file.Filename)
+		require.NoError(t, err)
+		names = append(names, filepath.ToSlash(rel))
+	}
+	assert.Equal(t, []string{"a.go", "sub/b.go", "sub/b_test.go"}, names)
+	assert.Equal(t, "example.com/pkgs", loaded[0].Package)
+
+	output, err := g.Generate(loaded[0].Syntax)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `DirectionNorth Direction = "north"`)
+
+	_, err = g.LoadPackages("./missing")
+	assert.Error(t, err)
+}
+
+// TestGeneratePackage tests that the enums of several files are generated into one file, and that
+// identifiers declared twice are reported
+func TestGeneratePackage(t *testing.T) {
+	g := NewGenerator(WithNames())
+	a, err := parser.ParseFile(g.fileSet, "a.go", "package test\n\n// ENUM(red, green)\ntype Color int\n", parser.ParseComments)
+	require.NoError(t, err)
+	b, err := parser.ParseFile(g.fileSet, "b.go", "package test\n\n// ENUM(alpha, beta)\ntype Greek string\n", parser.ParseComments)
+	require.NoError(t, err)
+
+	output, err := g.GeneratePackage([]*ast.File{b, a})
+	require.NoError(t, err)
+	outputStr := string(output)
+	assert.Equal(t, 1, strings.Count(outputStr, "package test"))
+	assert.Contains(t, outputStr, "ColorRed Color = iota")
+	assert.Contains(t, outputStr, `GreekAlpha Greek = "alpha"`)
+	assert.Less(t, strings.Index(outputStr, "ColorRed"), strings.Index(outputStr, "GreekAlpha"))
+
+	c, err := parser.ParseFile(g.fileSet, "c.go", "package test\n\n// ENUM(red)\ntype Shade int\n\nfunc (x Greek) String() string { return \"\" }\n", parser.ParseComments)
+	require.NoError(t, err)
+	g.NoPrefix = true
+	_, err = g.GeneratePackage([]*ast.File{a, b, c})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "generated identifier Red is declared more than once")
+	assert.Contains(t, err.Error(), "generated identifier Greek.String is already declared at c.go:6:16")
+
+	// Files without enums, including generated ones, are checked as well
+	g.NoPrefix = false
+	d, err := parser.ParseFile(g.fileSet, "d.go", "package test\n\nconst ColorGreen = \"green\"\n", parser.ParseComments)
+	require.NoError(t, err)
+	e, err := parser.ParseFile(g.fileSet, "e_enum.go", "// Code generated by go-enum DO NOT EDIT.\n\npackage test\n\nvar ErrInvalidGreek = 1\n", parser.ParseComments)
+	require.NoError(t, err)
+	_, err = g.GeneratePackage([]*ast.File{a, b, d, e})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "generated identifier ColorGreen is already declared at d.go:3:7")
+	assert.Contains(t, err.Error(), "generated identifier ErrInvalidGreek is already declared at e_enum.go:5:5")
+}
+
+// TestInspect tests the enums returned for inspection, with their positions
+func TestInspect(t *testing.T) {
+	input := `package test
+
+// Number is a number.
+// ENUM(
+//   one = 1 // The first
+//   _
+//   three [default]
+// )
+type Number uint8
+`
+	g := NewGenerator()
+	f, err := parser.ParseFile(g.fileSet, "test.go", input, parser.ParseComments)
+	require.NoError(t, err)
+
+	enums, err := g.Inspect(f)
+	require.NoError(t, err)
+	require.Len(t, enums, 1)
+
+	enum := enums[0]
+	assert.Equal(t, "Number", enum.Name)
+	assert.Equal(t, "uint8", enum.Type)
+	assert.Equal(t, "Number is a number.", enum.Comment)
+	assert.Equal(t, Position{Filename: "test.go", Line: 9, Column: 6}, enum.Position)
+	assert.Equal(t, Position{Filename: "test.go", Line: 4, Column: 4}, enum.DeclPosition)
+
+	raw, err := json.Marshal(enum.Values)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"raw_name": "one", "name": "One", "prefixed_name": "NumberOne", "value_str": "1", "value_int": 1, "comment": "The first", "is_default": false},
+		{"raw_name": "_", "name": "_", "prefixed_name": "_", "value_str": "_", "value_int": 2, "comment": "", "is_default": false},
+		{"raw_name": "three", "name": "Three", "prefixed_name": "NumberThree", "value_str": "three", "value_int": 3, "comment": "", "is_default": true}
+	]`, string(raw))
+}
+
+func TestCacheKey(t *testing.T) {
+	src := []byte("package test\n\n// ENUM(a, b)\ntype Letter int\n")
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "letter.go")
+
+	key, err := NewGenerator().CacheKey(filename, src)
+	require.NoError(t, err)
+
+	again, err := NewGenerator().CacheKey(filename, src)
+	require.NoError(t, err)
+	assert.Equal(t, key, again, "the key is stable")
+
+	changes := map[string]func() (string, error){
+		"source": func() (string, error) {
+			return NewGenerator().CacheKey(filename, append(src, '\n'))
+		},
+		"config": func() (string, error) {
+			return NewGenerator(WithMarshal()).CacheKey(filename, src)
+		},
+		"version": func() (string, error) {
+			g := NewGenerator()
+			g.Version = "v1.2.3"
+			return g.CacheKey(filename, src)
+		},
+		"go version": func() (string, error) {
+			return NewGenerator(WithGoVersion("1.18")).CacheKey(filename, src)
+		},
+	}
+	for name, change := range changes {
+		t.Run(name, func(t *testing.T) {
+			changed, err := change()
+			require.NoError(t, err)
+			assert.NotEqual(t, key, changed)
+		})
+	}
+
+	// The key changes with the contents of the templates, not only with their names
+	tmpl := filepath.Join(dir, "extra.tmpl")
+	require.NoError(t, os.WriteFile(tmpl, []byte(`{{define "extra"}}{{end}}`), 0o644))
+	g := NewGenerator(WithTemplates(tmpl))
+	before, err := g.CacheKey(filename, src)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tmpl, []byte(`{{define "extra"}}// extra{{end}}`), 0o644))
+	after, err := g.CacheKey(filename, src)
+	require.NoError(t, err)
+	assert.NotEqual(t, before, after)
+}
+
+func TestGenerateFromSource(t *testing.T) {
+	g := NewGenerator(WithMarshal(), WithIterators(), WithGoVersion("1.23"))
+	src, err := os.ReadFile(testExample)
+	require.NoError(t, err)
+
+	fromFile, err := g.GenerateFromFile(testExample)
+	require.NoError(t, err)
+	base := g.fileSet.Base()
+	fromSource, err := g.GenerateFromSource(testExample, src)
+	require.NoError(t, err)
+	assert.Equal(t, string(fromFile), string(fromSource))
+	// The source isn't added to the file set of the generator
+	assert.Equal(t, base, g.fileSet.Base())
+
+	// The file isn't read, so it doesn't have to exist
+	missing := filepath.Join(t.TempDir(), "missing", "color.go")
+	raw, err := g.GenerateFromSource(missing, []byte("package test\n\n// ENUM(red, green)\ntype Color int\n"))
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "ColorRed Color = iota")
+
+	_, err = g.GenerateFromSource(missing, []byte("package test\n\ntype Color int {\n"))
+	assert.ErrorContains(t, err, missing)
+}
+
+func TestGeneratorConcurrentUse(t *testing.T) {
+	g := NewGenerator(WithMarshal(), WithSQLDriver(), WithNames(), WithGuard(), WithGoVersion("1.23"))
+	files := []string{testExample, testExampleNoIota}
+
+	type outputs struct{ code, bench, tests string }
+	want := make(map[string]outputs)
+	sources := make(map[string][]byte)
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		require.NoError(t, err)
+		sources[file] = src
+		code, err := g.GenerateFromFile(file)
+		require.NoError(t, err)
+		bench, err := g.GenerateBenchmarkFromFile(file)
+		require.NoError(t, err)
+		tests, err := g.GenerateTestsFromFile(file)
+		require.NoError(t, err)
+		want[file] = outputs{string(code), string(bench), string(tests)}
+	}
+
+	var wg sync.WaitGroup
+	// The type information of the packages loaded meanwhile is added to the generator
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := g.LoadPackages(".")
+		assert.NoError(t, err)
+	}()
+	for i := 0; i < 8; i++ {
+		for _, file := range files {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				code, err := g.GenerateFromSource(file, sources[file])
+				assert.NoError(t, err)
+				assert.Equal(t, want[file].code, string(code))
+				code, err = g.GenerateFromFile(file)
+				assert.NoError(t, err)
+				assert.Equal(t, want[file].code, string(code))
+				bench, err := g.GenerateBenchmarkFromFile(file)
+				assert.NoError(t, err)
+				assert.Equal(t, want[file].bench, string(bench))
+				tests, err := g.GenerateTestsFromFile(file)
+				assert.NoError(t, err)
+				assert.Equal(t, want[file].tests, string(tests))
+				_, err = g.InspectFromFile(file)
+				assert.NoError(t, err)
+				parsed, err := g.ParseFiles(file)
+				assert.NoError(t, err)
+				_, err = g.GeneratePackage(parsed)
+				assert.NoError(t, err)
+			}()
+		}
+	}
+	wg.Wait()
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		config  GeneratorConfig
+		options [][]string
+	}{
+		"valid": {
+			config: GeneratorConfig{Marshal: true, NoParse: true, TypedErrors: true, Ordinal: true, OrdinalWrap: true, GoVersion: "1.23"},
+		},
+		"force lower and upper": {
+			config:  GeneratorConfig{ForceLower: true, ForceUpper: true},
+			options: [][]string{{"ForceLower", "ForceUpper"}},
+		},
+		"no parse and must parse": {
+			config:  GeneratorConfig{NoParse: true, MustParse: true},
+			options: [][]string{{"NoParse", "MustParse"}},
+		},
+		"open and default fallback": {
+			config:  GeneratorConfig{OpenEnum: true, DefaultFallback: true, Marshal: true},
+			options: [][]string{{"OpenEnum", "DefaultFallback"}},
+		},
+		"ordinal wrap without ordinal": {
+			config:  GeneratorConfig{OrdinalWrap: true},
+			options: [][]string{{"OrdinalWrap"}},
+		},
+		"parse options without parse": {
+			config:  GeneratorConfig{NoParse: true, CaseInsensitive: true, TypedErrors: true, Suggest: true, Normalizer: "key"},
+			options: [][]string{{"NoParse", "CaseInsensitive"}, {"NoParse", "TypedErrors"}, {"NoParse", "Suggest"}, {"NoParse", "Normalize"}},
+		},
+		"invalid normalizer and go version": {
+			config:  GeneratorConfig{Normalize: true, Normalizer: "strings.ToLower", GoVersion: "latest"},
+			options: [][]string{{"Normalizer"}, {"GoVersion"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.config.Validate()
+			if tc.options == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+
+			var options [][]string
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var configErr *ConfigError
+				require.ErrorAs(t, e, &configErr)
+				assert.Empty(t, configErr.Enum)
+				assert.NotEmpty(t, configErr.Reason)
+				options = append(options, configErr.Options)
+			}
+			assert.Equal(t, tc.options, options)
+		})
+	}
+}
+
+func TestValidateEnum(t *testing.T) {
+	withDefault := &Enum{Name: "Color", Type: "string", Values: []EnumValue{{Name: "Red", IsDefault: true}}}
+	withoutDefault := &Enum{Name: "Size", Type: "int", Values: []EnumValue{{Name: "Small"}}}
+	config := GeneratorConfig{SQLInt: true, DefaultFallback: true}
+
+	assert.NoError(t, config.ValidateEnum(withDefault))
+
+	err := config.ValidateEnum(withoutDefault)
+	require.Error(t, err)
+	assert.EqualError(t, err, "invalid options SQLInt for enum Size: SQLInt stores string enums as integers, and int enums are stored as integers already\n"+
+		"invalid options DefaultFallback for enum Size: there is no value marked with [default] to fall back to")
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, &ConfigError{Options: []string{"SQLInt"}, Enum: "Size", Reason: configErr.Reason}, configErr)
+}
+
+func TestGenerateWarnings(t *testing.T) {
+	var warnings []*ConfigError
+	g := NewGenerator(WithSQLInt(), WithWarnings(func(err *ConfigError) {
+		warnings = append(warnings, err)
+	}))
+	output, err := g.GenerateFromSource("test.go", []byte("package test\n\n// ENUM(small, large)\ntype Size int\n\n// ENUM(red, blue)\ntype Color string\n"))
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "SizeSmall Size = iota")
+	require.Len(t, warnings, 1)
+	assert.Equal(t, "Size", warnings[0].Enum)
+	assert.Equal(t, []string{"SQLInt"}, warnings[0].Options)
+
+	// The code skipped for an old go version is reported the same way
+	warnings = nil
+	g = NewGenerator(WithIterators(), WithEnumMap(), WithMatch(), WithGoVersion("1.17"), WithWarnings(func(err *ConfigError) {
+		warnings = append(warnings, err)
+	}))
+	_, err = g.GenerateFromSource("test.go", []byte("package test\n\n// ENUM(small, large)\ntype Size int\n"))
+	require.NoError(t, err)
+	var options []string
+	for _, warning := range warnings {
+		options = append(options, warning.Options...)
+		assert.Empty(t, warning.Enum)
+	}
+	assert.Equal(t, []string{"Iterators", "EnumMap", "Match"}, options)
+	assert.EqualError(t, warnings[2], "invalid options Match: the generic match functions are skipped, as they need go1.18 and the go version is 1.17")
+
+	// Without a callback the warnings are dropped
+	_, err = NewGenerator(WithSQLInt()).GenerateFromSource("test.go", []byte("package test\n\n// ENUM(small, large)\ntype Size int\n"))
+	require.NoError(t, err)
+}
+
+func TestNewGeneratorE(t *testing.T) {
+	g, err := NewGeneratorE(WithMarshal())
+	require.NoError(t, err)
+	assert.True(t, g.Marshal)
+
+	_, err = NewGeneratorE(WithForceLower(), WithForceUpper())
+	var configErr *ConfigError
+	require.ErrorAs(t, err, &configErr)
+	assert.Equal(t, []string{"ForceLower", "ForceUpper"}, configErr.Options)
+
+	missing := filepath.Join(t.TempDir(), "missing.tmpl")
+	_, err = NewGeneratorE(WithTemplates(missing))
+	assert.ErrorContains(t, err, "failed parsing template files")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	invalid := filepath.Join(t.TempDir(), "invalid.tmpl")
+	require.NoError(t, os.WriteFile(invalid, []byte(`{{define "invalid"}}{{ .name `), 0o644))
+	_, err = NewGeneratorWithConfigE(GeneratorConfig{TemplateFileNames: []string{invalid}})
+	assert.ErrorContains(t, err, "failed parsing template files")
+
+	// The constructors without an error still panic
+	assert.Panics(t, func() { NewGeneratorWithConfig(GeneratorConfig{TemplateFileNames: []string{invalid}}) })
+}
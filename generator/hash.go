@@ -0,0 +1,112 @@
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// declHashSuffix is the suffix of the constant holding the hash of the ENUM declaration in code
+// generated with the guard.
+const declHashSuffix = "DeclHash"
+
+// declarationHash returns the hash of the name, type and ENUM declaration of an enum.
+func declarationHash(name, typ, decl string) string {
+	sum := sha256.Sum256([]byte(name + "\x00" + typ + "\x00" + decl))
+	return hex.EncodeToString(sum[:8])
+}
+
+// StaleEnumsFromFile parses the input file and the file generated from it, and returns the enums whose
+// ENUM declaration changed since the file was generated.
+func (g *Generator) StaleEnumsFromFile(inputFile, generatedFile string) ([]string, error) {
+	source, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("stale: error parsing input file '%s': %s", inputFile, err)
+	}
+	generated, err := g.parseFile(generatedFile)
+	if err != nil {
+		return nil, fmt.Errorf("stale: error parsing generated file '%s': %s", generatedFile, err)
+	}
+	return g.StaleEnums(source, generated)
+}
+
+// StaleEnums returns the sorted names of the enums in the source file whose ENUM declaration doesn't
+// match the hash in the generated file, including the enums that have no hash because they were
+// generated without the guard or not generated at all.
+func (g *Generator) StaleEnums(source, generated *ast.File) ([]string, error) {
+	hashes := generatedHashes(generated)
+	var stale []string
+	for name, ts := range g.inspect(source) {
+		enum, err := g.parseEnum(ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing enum %q: %w", name, err)
+		}
+		if hashes[enum.Name] != enum.Hash {
+			stale = append(stale, enum.Name)
+		}
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
+
+// generatedHashes returns the hashes of the ENUM declarations in a generated file, by enum name.
+func generatedHashes(f *ast.File) map[string]string {
+	hashes := make(map[string]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Names) != 1 || len(vs.Values) != 1 {
+				continue
+			}
+			name := vs.Names[0].Name
+			if !strings.HasPrefix(name, "_") || !strings.HasSuffix(name, declHashSuffix) {
+				continue
+			}
+			lit, ok := vs.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			if hash, err := strconv.Unquote(lit.Value); err == nil {
+				hashes[strings.TrimSuffix(strings.TrimPrefix(name, "_"), declHashSuffix)] = hash
+			}
+		}
+	}
+	return hashes
+}
+
+// CacheKey returns a key that changes whenever the code generated for the source of filename could
+// change: with the source, the go-enum version, the configuration, the templates or the go version the
+// code is generated for.  Tools can use it to skip the files that didn't change since the last run.
+func (g *Generator) CacheKey(filename string, src []byte) (string, error) {
+	config, err := json.Marshal(g.GeneratorConfig)
+	if err != nil {
+		return "", fmt.Errorf("cache key: failed encoding the configuration: %w", err)
+	}
+
+	h := sha256.New()
+	for _, part := range []string{g.Version, g.Revision, g.BuildDate, g.BuiltBy, string(config), embeddedTemplatesHash(), g.targetGoVersion(filename)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	for _, name := range g.TemplateFileNames {
+		raw, err := os.ReadFile(name)
+		if err != nil {
+			return "", fmt.Errorf("cache key: failed reading template file '%s': %w", name, err)
+		}
+		h.Write(raw)
+		h.Write([]byte{0})
+	}
+	h.Write(src)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
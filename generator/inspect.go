@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// InspectFromFile parses the input file and returns the enums declared in it, as they are passed to the
+// templates.
+func (g *Generator) InspectFromFile(inputFile string) ([]*Enum, error) {
+	f, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("inspect: error parsing input file '%s': %s", inputFile, err)
+	}
+	return g.Inspect(f)
+}
+
+// Inspect returns the enums declared in the parsed AST file in the order they are generated, as they
+// are passed to the templates.
+func (g *Generator) Inspect(f *ast.File) ([]*Enum, error) {
+	specs := g.inspect(f)
+	keys := make([]string, 0, len(specs))
+	for key := range specs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	enums := make([]*Enum, 0, len(keys))
+	for _, name := range keys {
+		ts := specs[name]
+		enum, err := g.parseEnum(ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing enum %q: %w", name, err)
+		}
+		enum.Position = g.position(ts.Name.Pos())
+		for _, c := range ts.Doc.List {
+			if i := strings.Index(c.Text, `ENUM(`); i >= 0 {
+				enum.DeclPosition = g.position(c.Slash + token.Pos(i))
+				break
+			}
+		}
+		enums = append(enums, enum)
+	}
+	return enums, nil
+}
+
+// position returns the position of pos in the parsed files.
+func (g *Generator) position(pos token.Pos) Position {
+	p := g.fileSet.Position(pos)
+	return Position{Filename: p.Filename, Line: p.Line, Column: p.Column}
+}
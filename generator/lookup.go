@@ -0,0 +1,405 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxSwitchLookup is the largest number of parse keys that will use a generated
+	// switch statement, anything larger uses a minimal perfect hash.
+	maxSwitchLookup = 32
+	// perfectHashBucketSize is the average number of keys per bucket when building the hash.
+	perfectHashBucketSize = 4
+	// perfectHashMaxSeed bounds the search for a seed that places a bucket without collisions.
+	perfectHashMaxSeed = 1 << 24
+)
+
+// lookupEntry is a single string key and the constant it parses to.
+type lookupEntry struct {
+	Key   string
+	Value string
+}
+
+// Quoted returns the key as a go string literal.
+func (e lookupEntry) Quoted() string {
+	return strconv.Quote(e.Key)
+}
+
+// lookupTable holds everything needed to render a map free parse lookup.
+// When Seeds is empty the lookup is rendered as a switch over Entries,
+// otherwise Slots holds the entries in minimal perfect hash order.
+type lookupTable struct {
+	Entries []lookupEntry
+	Seeds   []uint32
+	Slots   []lookupEntry
+}
+
+// IsHash reports whether the table should be rendered as a perfect hash.
+func (l *lookupTable) IsHash() bool {
+	return len(l.Seeds) > 0
+}
+
+// stringCase is the name of a single enum constant as a slice of the `_{{ENUM}}Name` constant.
+type stringCase struct {
+	Value string
+	Start int
+	End   int
+}
+
+// stringIndex holds the positions of each name in the `_{{ENUM}}Name` constant.  When the
+// values are dense, Offsets allows indexing an array by value in the same fashion as the
+// stringer tool, otherwise the Cases are used in a switch statement.
+type stringIndex struct {
+	Cases    []stringCase
+	Dense    bool
+	Min      string
+	Offsets  []int
+	Type     string
+	HasHoles bool
+}
+
+// enumNames returns the names of the enum values, as they appear in the `_{{ENUM}}Name` constant,
+// for each value that isn't skipped.
+func enumNames(e Enum, forceLower, forceUpper bool) []string {
+	names := make([]string, 0, len(e.Values))
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		next := val.RawName
+		if forceLower {
+			next = strings.ToLower(next)
+		}
+		if forceUpper {
+			next = strings.ToUpper(next)
+		}
+		names = append(names, next)
+	}
+	return names
+}
+
+// stringNames returns the names returned by String(), in the same order as ordinals.  A string
+// enum returns its value, which may differ from its name.
+func stringNames(e Enum, forceLower, forceUpper bool) []string {
+	if e.Type != "string" {
+		return enumNames(e, forceLower, forceUpper)
+	}
+	values := ordinals(e)
+	names := make([]string, 0, len(values))
+	for _, val := range values {
+		names = append(names, val.ValueStr)
+	}
+	return names
+}
+
+// parseEntries returns the keys accepted by the parse method in the same order and
+// with the same precedence as the `_{{ENUM}}Value` map.
+func parseEntries(e Enum, lowercase, forceLower, forceUpper bool) []lookupEntry {
+	var keys []string
+	if e.Type == "string" {
+		for _, val := range e.Values {
+			if val.Name != skipHolder {
+				keys = append(keys, val.ValueStr)
+			}
+		}
+	} else {
+		keys = enumNames(e, forceLower, forceUpper)
+	}
+
+	var values []string
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			values = append(values, val.PrefixedName)
+		}
+	}
+
+	seen := make(map[string]bool, len(keys)*2)
+	entries := make([]lookupEntry, 0, len(keys)*2)
+	add := func(key, value string) {
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		entries = append(entries, lookupEntry{Key: key, Value: value})
+	}
+	for i, key := range keys {
+		add(key, values[i])
+		if lowercase {
+			add(strings.ToLower(key), values[i])
+		}
+	}
+	return entries
+}
+
+// buildLookup creates the lookup table used for a map free parse method.  Small enums
+// use a switch, which the compiler turns into a length and content search, while larger
+// enums get a minimal perfect hash.
+func buildLookup(e Enum, lowercase, forceLower, forceUpper bool) *lookupTable {
+	table := &lookupTable{Entries: parseEntries(e, lowercase, forceLower, forceUpper)}
+	if len(table.Entries) > maxSwitchLookup {
+		table.Seeds, table.Slots = perfectHash(table.Entries)
+	}
+	return table
+}
+
+// buildStringIndex returns the positions needed to look up names without a map.  The
+// index is only dense when the values are strictly increasing and fill at least half
+// of the range between the smallest and largest value.
+func buildStringIndex(e Enum, forceLower, forceUpper bool) *stringIndex {
+	names := enumNames(e, forceLower, forceUpper)
+	idx := &stringIndex{Cases: make([]stringCase, 0, len(names))}
+
+	offset := 0
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		name := names[len(idx.Cases)]
+		idx.Cases = append(idx.Cases, stringCase{Value: val.PrefixedName, Start: offset, End: offset + len(name)})
+		offset += len(name)
+	}
+
+	switch {
+	case offset < 1<<8:
+		idx.Type = "uint8"
+	case offset < 1<<16:
+		idx.Type = "uint16"
+	default:
+		idx.Type = "uint32"
+	}
+
+	if e.Type == "string" || len(names) == 0 {
+		return idx
+	}
+
+	unsigned := strings.HasPrefix(e.Type, "u")
+	positions := make([]uint64, 0, len(names))
+	var first, last uint64
+	for _, val := range e.Values {
+		if val.Name == skipHolder {
+			continue
+		}
+		var pos uint64
+		if unsigned {
+			pos = val.ValueInt.(uint64)
+		} else {
+			pos = uint64(val.ValueInt.(int64))
+		}
+		if len(positions) == 0 {
+			first = pos
+		} else if unsigned && pos <= last || !unsigned && int64(pos) <= int64(last) {
+			// Values must be strictly increasing for the offsets to line up with the name constant
+			return idx
+		}
+		positions = append(positions, pos-first)
+		last = pos
+	}
+
+	if positions[len(positions)-1] >= uint64(2*len(names)) {
+		return idx
+	}
+	span := positions[len(positions)-1] + 1
+
+	idx.Dense = true
+	if unsigned {
+		idx.Min = strconv.FormatUint(first, 10)
+	} else {
+		idx.Min = strconv.FormatInt(int64(first), 10)
+	}
+
+	idx.Offsets = make([]int, 0, span+1)
+	idx.Offsets = append(idx.Offsets, 0)
+	next := 0
+	for pos := uint64(0); pos < span; pos++ {
+		if positions[next] == pos {
+			next++
+		} else {
+			idx.HasHoles = true
+		}
+		if next > 0 {
+			idx.Offsets = append(idx.Offsets, idx.Cases[next-1].End)
+		} else {
+			idx.Offsets = append(idx.Offsets, 0)
+		}
+	}
+	return idx
+}
+
+// perfectHash builds a minimal perfect hash for the entries using the hash and displace
+// approach: keys are split into buckets by a first hash, and each bucket, largest first,
+// is assigned the first seed that places all of its keys into free slots.  It returns nil
+// slices if no seeds could be found, in which case the caller should use a switch.
+func perfectHash(entries []lookupEntry) ([]uint32, []lookupEntry) {
+	n := uint32(len(entries))
+	numBuckets := (n + perfectHashBucketSize - 1) / perfectHashBucketSize
+
+	hashes := make([]uint32, len(entries))
+	buckets := make([][]int, numBuckets)
+	for i, entry := range entries {
+		hashes[i] = lookupHash(entry.Key)
+		b := lookupMix(hashes[i], 0) % numBuckets
+		buckets[b] = append(buckets[b], i)
+	}
+
+	order := make([]int, numBuckets)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return len(buckets[order[i]]) > len(buckets[order[j]])
+	})
+
+	seeds := make([]uint32, numBuckets)
+	used := make([]bool, n)
+	slots := make([]lookupEntry, n)
+	placed := make([]uint32, 0, perfectHashBucketSize*2)
+	for _, b := range order {
+		bucket := buckets[b]
+		if len(bucket) == 0 {
+			continue
+		}
+		found := false
+		for seed := uint32(1); seed < perfectHashMaxSeed; seed++ {
+			placed = placed[:0]
+			ok := true
+			for _, i := range bucket {
+				slot := lookupMix(hashes[i], seed) % n
+				if used[slot] {
+					ok = false
+					break
+				}
+				used[slot] = true
+				placed = append(placed, slot)
+			}
+			if ok {
+				for k, i := range bucket {
+					slots[placed[k]] = entries[i]
+				}
+				seeds[b] = seed
+				found = true
+				break
+			}
+			for _, slot := range placed {
+				used[slot] = false
+			}
+		}
+		if !found {
+			return nil, nil
+		}
+	}
+	return seeds, slots
+}
+
+// lookupHash is the FNV-1a hash of s.  The generated code contains the exact same function,
+// so any change here must be made to the `fastlookup` template too.
+func lookupHash(s string) uint32 {
+	h := uint32(2166136261)
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// lookupMix seeds the hash and runs it through the murmur3 finalizer, so that the
+// string only has to be hashed once no matter how many seeds are tried.
+func lookupMix(h, seed uint32) uint32 {
+	h ^= seed
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+// foldGroup holds the parse keys of a single length for the case insensitive lookup.
+type foldGroup struct {
+	Length  int
+	Entries []lookupEntry
+}
+
+// foldGroups returns the parse keys grouped by their length in bytes, so that the case
+// insensitive lookup only has to compare names that could match.  Keys that only differ
+// by case keep the first declared value, the same as the lowercase lookup did.
+func foldGroups(e Enum, forceLower, forceUpper bool) []foldGroup {
+	var groups []foldGroup
+	index := make(map[int]int)
+	seen := make(map[string]bool)
+	for _, entry := range parseEntries(e, false, forceLower, forceUpper) {
+		folded := strings.ToLower(entry.Key)
+		if seen[folded] {
+			continue
+		}
+		seen[folded] = true
+		i, ok := index[len(entry.Key)]
+		if !ok {
+			i = len(groups)
+			index[len(entry.Key)] = i
+			groups = append(groups, foldGroup{Length: len(entry.Key)})
+		}
+		groups[i].Entries = append(groups[i].Entries, entry)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i].Length < groups[j].Length
+	})
+	return groups
+}
+
+// normalizeSeparators are the characters dropped by the built in normalizer.
+const normalizeSeparators = "-_. "
+
+// normalizedLookup holds the keys for the normalized parse lookup.
+type normalizedLookup struct {
+	Entries []lookupEntry
+	// MaxLen is the length of the longest key, which sizes the buffer used to normalize the input.
+	MaxLen int
+}
+
+// normalizeKey lowercases the ASCII letters in s and drops the separators, the same as the
+// generated `normalized{{ENUM}}` func does to its input.
+func normalizeKey(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if strings.IndexByte(normalizeSeparators, c) >= 0 {
+			continue
+		}
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// buildNormalized returns the keys for the normalized parse lookup.  With the built in
+// normalizer the keys are normalized here, so two values that end up with the same key
+// are reported as an error.  A custom normalizer only runs when the generated code is
+// initialized, so the keys are left as they are.
+func buildNormalized(e Enum, forceLower, forceUpper bool, custom string) (*normalizedLookup, error) {
+	lookup := &normalizedLookup{}
+	owners := make(map[string]lookupEntry)
+	for _, entry := range parseEntries(e, false, forceLower, forceUpper) {
+		if custom == "" {
+			key := normalizeKey(entry.Key)
+			if owner, ok := owners[key]; ok {
+				if owner.Value != entry.Value {
+					return nil, fmt.Errorf("%q and %q both normalize to %q", owner.Key, entry.Key, key)
+				}
+				continue
+			}
+			owners[key] = entry
+			entry = lookupEntry{Key: key, Value: entry.Value}
+		}
+		if len(entry.Key) > lookup.MaxLen {
+			lookup.MaxLen = len(entry.Key)
+		}
+		lookup.Entries = append(lookup.Entries, entry)
+	}
+	return lookup, nil
+}
@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/pipeline"
+)
+
+// MessagesFileName is the name of the gotext translation file written for each locale.
+const MessagesFileName = "messages.gotext.json"
+
+// DisplayMessage is the message looked up by the Display method of an enum value.
+type DisplayMessage struct {
+	// Package is the name of the package the enum is declared in.
+	Package string
+	// Enum is the name of the enum type.
+	Enum string
+	// Key is the key of the message in the catalog, {{ENUM}}.name.
+	Key string
+	// Name is the value returned by String(), which is used when there is no translation.
+	Name string
+	// Comment is the comment of the value, for the translators.
+	Comment string
+}
+
+// DisplayMessagesFromFile parses the input file and returns the messages for the Display method of
+// every enum in it.
+func (g *Generator) DisplayMessagesFromFile(inputFile string) ([]DisplayMessage, error) {
+	f, err := g.parseFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("extract: error parsing input file '%s': %s", inputFile, err)
+	}
+	return g.DisplayMessages(f)
+}
+
+// DisplayMessages returns the messages for the Display method of every enum in the parsed AST file, in
+// the order the enums are generated.
+func (g *Generator) DisplayMessages(f *ast.File) ([]DisplayMessage, error) {
+	enums, err := g.Inspect(f)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgs []DisplayMessage
+	for _, enum := range enums {
+		values := ordinals(*enum)
+		for i, n := range stringNames(*enum, g.ForceLower, g.ForceUpper) {
+			msgs = append(msgs, DisplayMessage{
+				Package: f.Name.Name,
+				Enum:    enum.Name,
+				Key:     enum.Name + "." + n,
+				Name:    n,
+				Comment: values[i].Comment,
+			})
+		}
+	}
+	return msgs, nil
+}
+
+// UpdateMessageFiles writes the messages to the messages.gotext.json file of each language in dir,
+// keeping the translations already in the files.  The messages of the enums in msgs that are no
+// longer declared are removed, and the messages of the other enums are left as they are.  The
+// source language gets the names as translations.  It returns the files written.
+func UpdateMessageFiles(dir string, source language.Tag, langs []language.Tag, msgs []DisplayMessage) ([]string, error) {
+	var written []string
+	for _, lang := range langs {
+		file := filepath.Join(dir, lang.String(), MessagesFileName)
+		existing, err := readMessages(file)
+		if err != nil {
+			return written, err
+		}
+		existing.Language = lang
+		existing.Messages = mergeMessages(existing.Messages, msgs, lang == source)
+
+		var raw bytes.Buffer
+		enc := json.NewEncoder(&raw)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "    ")
+		if err := enc.Encode(existing); err != nil {
+			return written, fmt.Errorf("failed encoding %s: %w", file, err)
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			return written, err
+		}
+		if err := os.WriteFile(file, raw.Bytes(), 0o644); err != nil {
+			return written, fmt.Errorf("failed writing %s: %w", file, err)
+		}
+		written = append(written, file)
+	}
+	return written, nil
+}
+
+// readMessages reads a gotext translation file, returning no messages when it doesn't exist yet.
+func readMessages(file string) (*pipeline.Messages, error) {
+	raw, err := os.ReadFile(file)
+	if errors.Is(err, os.ErrNotExist) {
+		return &pipeline.Messages{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m pipeline.Messages
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("failed parsing %s: %w", file, err)
+	}
+	return &m, nil
+}
+
+// mergeMessages replaces the messages of the enums in msgs, keeping their translations, and sorts the
+// messages by enum.
+func mergeMessages(existing []pipeline.Message, msgs []DisplayMessage, source bool) []pipeline.Message {
+	touched := make(map[string]bool)
+	for _, m := range msgs {
+		touched[m.Enum] = true
+	}
+	previous := make(map[string]pipeline.Message)
+	var merged []pipeline.Message
+	for _, m := range existing {
+		if touched[messageEnum(m.Key)] {
+			previous[m.Key] = m
+			continue
+		}
+		merged = append(merged, m)
+	}
+
+	for _, m := range msgs {
+		msg := pipeline.Message{
+			ID:      pipeline.IDList{m.Key},
+			Key:     m.Key,
+			Message: pipeline.Text{Msg: m.Name},
+			Comment: m.Comment,
+		}
+		if prev, ok := previous[m.Key]; ok {
+			msg.Translation = prev.Translation
+			msg.TranslatorComment = prev.TranslatorComment
+			msg.Fuzzy = prev.Fuzzy
+		}
+		if source && msg.Translation.Msg == "" {
+			msg.Translation = pipeline.Text{Msg: m.Name}
+		}
+		merged = append(merged, msg)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return messageEnum(merged[i].Key) < messageEnum(merged[j].Key)
+	})
+	return merged
+}
+
+// messageEnum returns the enum of a message key.
+func messageEnum(key string) string {
+	enum, _, _ := strings.Cut(key, ".")
+	return enum
+}
+
+// DisplayCatalog returns the source of a go file that adds the translations of the messages.gotext.json
+// files in dir to the default message catalog.
+func DisplayCatalog(pkg, dir string, langs []language.Tag) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go-enum DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import (\n\t\"golang.org/x/text/language\"\n\t\"golang.org/x/text/message\"\n)\n\n")
+	buf.WriteString("func init() {\n\tfor _, m := range [...]struct{ tag, key, msg string }{\n")
+	for _, lang := range langs {
+		file := filepath.Join(dir, lang.String(), MessagesFileName)
+		msgs, err := readMessages(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range msgs.Messages {
+			if m.Translation.Msg == "" {
+				continue
+			}
+			// The translations are format strings
+			msg := strings.ReplaceAll(m.Translation.Msg, "%", "%%")
+			fmt.Fprintf(&buf, "\t\t{%s, %s, %s},\n", strconv.Quote(lang.String()), strconv.Quote(m.Key), strconv.Quote(msg))
+		}
+	}
+	buf.WriteString("\t} {\n\t\t_ = message.SetString(language.MustParse(m.tag), m.key, m.msg)\n\t}\n}\n")
+	return format.Source(buf.Bytes())
+}
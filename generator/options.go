@@ -23,9 +23,31 @@ type GeneratorConfig struct {
 	ForceUpper        bool              `json:"force_upper"`
 	NoComments        bool              `json:"no_comments"`
 	NoParse           bool              `json:"no_parse"`
+	OpenEnum          bool              `json:"open_enum"`
+	DefaultFallback   bool              `json:"default_fallback"`
+	FastLookup        bool              `json:"fast_lookup"`
+	TypedErrors       bool              `json:"typed_errors"`
+	Suggest           bool              `json:"suggest"`
+	Normalize         bool              `json:"normalize"`
+	Normalizer        string            `json:"normalizer"`
+	Ordinal           bool              `json:"ordinal"`
+	OrdinalWrap       bool              `json:"ordinal_wrap"`
+	Iterators         bool              `json:"iterators"`
+	GoVersion         string            `json:"go_version"`
+	Set               bool              `json:"set"`
+	EnumMap           bool              `json:"enum_map"`
+	Match             bool              `json:"match"`
+	Display           bool              `json:"display"`
+	Validator         bool              `json:"validator"`
+	ValidateMethod    bool              `json:"validate_method"`
+	Guard             bool              `json:"guard"`
 	BuildTags         []string          `json:"build_tags"`
 	ReplacementNames  map[string]string `json:"replacement_names"`
 	TemplateFileNames []string          `json:"template_file_names"`
+	// Warn is called with each option that has no effect on the code being generated: the ones that
+	// ValidateEnum reports for an enum, and the ones skipped because the go version is too old.  It is
+	// called from every goroutine that generates with the same Generator.
+	Warn func(*ConfigError) `json:"-"`
 }
 
 func NewGeneratorConfig() *GeneratorConfig {
@@ -63,7 +85,6 @@ func WithLowercaseVariant() Option {
 // WithCaseInsensitiveParse is used to change the enum const values generated to not have the enum on them.
 func WithCaseInsensitiveParse() Option {
 	return func(g *GeneratorConfig) {
-		g.LowercaseLookup = true
 		g.CaseInsensitive = true
 	}
 }
@@ -212,3 +233,156 @@ func WithNoParse() Option {
 		g.NoParse = true
 	}
 }
+
+// WithOpenEnum is used to preserve unknown values when unmarshalling or scanning
+// instead of returning an error, so newer producers don't break older consumers.
+func WithOpenEnum() Option {
+	return func(g *GeneratorConfig) {
+		g.OpenEnum = true
+	}
+}
+
+// WithDefaultFallback is used to make decoding fall back to the `[default]` value
+// instead of returning an error for empty or invalid input.
+func WithDefaultFallback() Option {
+	return func(g *GeneratorConfig) {
+		g.DefaultFallback = true
+	}
+}
+
+// WithFastLookup is used to generate String and Parse methods that use arrays,
+// switches or a perfect hash instead of map lookups.
+func WithFastLookup() Option {
+	return func(g *GeneratorConfig) {
+		g.FastLookup = true
+	}
+}
+
+// WithTypedErrors is used to make parse return an `Invalid{{ENUM}}Error` holding the
+// rejected input and the valid names.
+func WithTypedErrors() Option {
+	return func(g *GeneratorConfig) {
+		g.TypedErrors = true
+	}
+}
+
+// WithSuggestions is used to add a "did you mean" suggestion to the typed parse errors.
+func WithSuggestions() Option {
+	return func(g *GeneratorConfig) {
+		g.TypedErrors = true
+		g.Suggest = true
+	}
+}
+
+// WithNormalize is used to make parse ignore case and the `-`, `_`, `.` and space separators.
+func WithNormalize() Option {
+	return func(g *GeneratorConfig) {
+		g.Normalize = true
+	}
+}
+
+// WithNormalizer is used to normalize the names and the parse input with a `func(string) string`
+// from the enum's package instead of the built in normalizer.
+func WithNormalizer(funcName string) Option {
+	return func(g *GeneratorConfig) {
+		g.Normalize = true
+		g.Normalizer = funcName
+	}
+}
+
+// WithOrdinal is used to add Ordinal, Next, Prev and Compare methods, based on declaration
+// order, along with the {{ENUM}}Min, {{ENUM}}Max and {{ENUM}}Count constants.
+func WithOrdinal() Option {
+	return func(g *GeneratorConfig) {
+		g.Ordinal = true
+	}
+}
+
+// WithOrdinalWrap is used to make Next and Prev wrap around at the ends of the enum.
+func WithOrdinalWrap() Option {
+	return func(g *GeneratorConfig) {
+		g.Ordinal = true
+		g.OrdinalWrap = true
+	}
+}
+
+// WithIterators is used to add iter.Seq functions over the enum values and names.  They are
+// skipped when the module is older than go1.23.
+func WithIterators() Option {
+	return func(g *GeneratorConfig) {
+		g.Iterators = true
+	}
+}
+
+// WithGoVersion is used to set the go version the code is generated for, instead of reading
+// it from the go.mod of the input file.
+func WithGoVersion(version string) Option {
+	return func(g *GeneratorConfig) {
+		g.GoVersion = version
+	}
+}
+
+// WithSet is used to add a {{ENUM}}Set type, which marshals as a list of names when marshalling
+// or SQL is enabled.
+func WithSet() Option {
+	return func(g *GeneratorConfig) {
+		g.Set = true
+	}
+}
+
+// WithEnumMap is used to add a generic {{ENUM}}Map[V] type backed by an array indexed by ordinal.
+// It is skipped when the module is older than go1.18.
+func WithEnumMap() Option {
+	return func(g *GeneratorConfig) {
+		g.EnumMap = true
+	}
+}
+
+// WithMatch is used to add a Match method, and a generic Match{{ENUM}} function, that take a
+// function for every declared value.  The generic function is skipped when the module is older
+// than go1.18.
+func WithMatch() Option {
+	return func(g *GeneratorConfig) {
+		g.Match = true
+	}
+}
+
+// WithDisplay is used to add a Display method, which translates the name with the default message
+// catalog of golang.org/x/text/message.
+func WithDisplay() Option {
+	return func(g *GeneratorConfig) {
+		g.Display = true
+	}
+}
+
+// WithValidator is used to add a Register{{ENUM}}Validation function, which registers a tag named after
+// the enum with github.com/go-playground/validator.
+func WithValidator() Option {
+	return func(g *GeneratorConfig) {
+		g.Validator = true
+	}
+}
+
+// WithValidateMethod is used to add a Validate method, which satisfies the Validatable interface of
+// ozzo-validation.
+func WithValidateMethod() Option {
+	return func(g *GeneratorConfig) {
+		g.ValidateMethod = true
+	}
+}
+
+// WithGuard is used to add a function that fails to compile when the constants no longer have the
+// declared values, and a constant holding the hash of the ENUM declaration.
+func WithGuard() Option {
+	return func(g *GeneratorConfig) {
+		g.Guard = true
+	}
+}
+
+// WithWarnings is used to receive the options that have no effect on the code being generated, which
+// are dropped otherwise.
+func WithWarnings(warn func(*ConfigError)) Option {
+	return func(g *GeneratorConfig) {
+		g.Warn = warn
+	}
+}
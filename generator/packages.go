@@ -0,0 +1,238 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// PackageFile is a file declaring enums, in a package loaded with LoadPackages.
+type PackageFile struct {
+	// Package is the import path of the package.
+	Package string
+	// Filename is the absolute path of the file.
+	Filename string
+	// Syntax is the parsed file, which can be passed to Generate, GenerateBenchmark and GenerateTests.
+	Syntax *ast.File
+}
+
+// LoadPackages loads the packages matching the patterns, like `./...`, with go/packages, and returns
+// the files declaring enums sorted by name.  Test files are included, and the build flags, like the
+// build tags, are taken from GOFLAGS.  The type information of the packages is kept, so enums can be
+// declared with a named type whose underlying type is an integer or a string.  Type errors don't
+// fail the load, since the code using the enums doesn't compile until they are generated.
+func (g *Generator) LoadPackages(patterns ...string) ([]PackageFile, error) {
+	cfg := &packages.Config{
+		Mode:  packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo,
+		Fset:  g.fileSet,
+		Tests: true,
+		// The ENUM declarations are found through the objects resolved by the parser
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			return parser.ParseFile(fset, filename, src, parser.ParseComments)
+		},
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed loading packages %v: %w", patterns, err)
+	}
+
+	var errs []error
+	var infos []*types.Info
+	seen := make(map[string]bool)
+	var files []PackageFile
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		// The compiler errors of a package that doesn't type check are list errors as well
+		typeErrors := false
+		for _, pkgErr := range pkg.Errors {
+			typeErrors = typeErrors || pkgErr.Kind == packages.TypeError
+		}
+		for _, pkgErr := range pkg.Errors {
+			if pkgErr.Kind == packages.ParseError || (pkgErr.Kind == packages.ListError && !typeErrors) {
+				errs = append(errs, pkgErr)
+			}
+		}
+		if pkg.TypesInfo != nil {
+			infos = append(infos, pkg.TypesInfo)
+		}
+		for _, f := range pkg.Syntax {
+			filename := g.fileSet.Position(f.Pos()).Filename
+			// The test variant of a package has the files of the package again
+			if seen[filename] || ast.IsGenerated(f) || len(g.inspect(f)) == 0 {
+				continue
+			}
+			seen[filename] = true
+			files = append(files, PackageFile{Package: pkg.PkgPath, Filename: filename, Syntax: f})
+		}
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	g.mu.Lock()
+	g.typesInfo = append(g.typesInfo, infos...)
+	g.mu.Unlock()
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Filename < files[j].Filename })
+	return files, nil
+}
+
+// underlyingType returns the type of the enum for the templates.  It is the declared type, unless the
+// enum is declared with a named type of a loaded package, where it is the underlying basic type.
+func (g *Generator) underlyingType(ts *ast.TypeSpec) string {
+	declared := fmt.Sprintf("%s", ts.Type)
+	if _, ok := ts.Type.(*ast.Ident); ok && types.Universe.Lookup(declared) != nil {
+		return declared
+	}
+	g.mu.RLock()
+	infos := g.typesInfo
+	g.mu.RUnlock()
+	for _, info := range infos {
+		obj, ok := info.Defs[ts.Name]
+		if !ok || obj == nil {
+			continue
+		}
+		if basic, ok := obj.Type().Underlying().(*types.Basic); ok {
+			return basic.Name()
+		}
+	}
+	return declared
+}
+
+// ParseFiles parses the input files, to generate the enums of a package with GeneratePackage.
+func (g *Generator) ParseFiles(inputFiles ...string) ([]*ast.File, error) {
+	files := make([]*ast.File, 0, len(inputFiles))
+	for _, inputFile := range inputFiles {
+		f, err := g.parseFile(inputFile)
+		if err != nil {
+			return nil, fmt.Errorf("generate: error parsing input file '%s': %s", inputFile, err)
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// GeneratePackage generates a single file with the enums found in the parsed AST files of a package, in
+// the order of their names.  It fails when an identifier the generated code declares, like the helper
+// variables of an enum, is declared twice or is already declared in the files.  Pass every file of the
+// package, including the ones without enums and the generated ones, so all of them are checked.
+func (g *Generator) GeneratePackage(files []*ast.File) ([]byte, error) {
+	return g.generatePackage(files, enumTemplateName, g.userTemplateNames)
+}
+
+// GeneratePackageBenchmark generates a single test file with the benchmarks for the enums found in the
+// parsed AST files of a package.
+func (g *Generator) GeneratePackageBenchmark(files []*ast.File) ([]byte, error) {
+	return g.generatePackage(files, benchmarkTemplateName, nil)
+}
+
+// GeneratePackageTests generates a single test file with round trip tests and fuzz targets for the enums
+// found in the parsed AST files of a package.
+func (g *Generator) GeneratePackageTests(files []*ast.File) ([]byte, error) {
+	return g.generatePackage(files, testTemplateName, nil)
+}
+
+// generatePackage generates the code for the enums of the files, and checks it for identifiers that
+// collide.
+func (g *Generator) generatePackage(files []*ast.File, enumTemplate func(*Enum) string, extraTemplates []string) ([]byte, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+	raw, err := g.generate(files, g.fileGoVersion(files[0]), enumTemplate, extraTemplates)
+	if err != nil || raw == nil {
+		return raw, err
+	}
+	if err := g.checkCollisions(raw, files); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// checkCollisions returns an error for each identifier declared more than once by the generated code,
+// or declared by both the generated code and the files.
+func (g *Generator) checkCollisions(raw []byte, files []*ast.File) error {
+	generated, err := parser.ParseFile(token.NewFileSet(), "", raw, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("failed parsing generated code: %w", err)
+	}
+
+	declared := make(map[string]token.Pos)
+	for _, f := range files {
+		for _, name := range declaredNames(f) {
+			declared[name.Name] = name.Pos
+		}
+	}
+
+	var errs []error
+	seen := make(map[string]bool)
+	for _, name := range declaredNames(generated) {
+		if seen[name.Name] {
+			errs = append(errs, fmt.Errorf("generated identifier %s is declared more than once", name.Name))
+			continue
+		}
+		seen[name.Name] = true
+		if pos, ok := declared[name.Name]; ok {
+			errs = append(errs, fmt.Errorf("generated identifier %s is already declared at %s", name.Name, g.fileSet.Position(pos)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// declaredName is a package level identifier, or a method as Type.Method.
+type declaredName struct {
+	Name string
+	Pos  token.Pos
+}
+
+// declaredNames returns the package level identifiers and the methods declared in the file, without
+// the blank identifiers.
+func declaredNames(f *ast.File) []declaredName {
+	var names []declaredName
+	add := func(prefix string, ident *ast.Ident) {
+		if ident.Name != "_" {
+			names = append(names, declaredName{Name: prefix + ident.Name, Pos: ident.Pos()})
+		}
+	}
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv != nil && len(decl.Recv.List) > 0 {
+				add(receiverName(decl.Recv.List[0].Type)+".", decl.Name)
+			} else if decl.Name.Name != "init" {
+				add("", decl.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					add("", spec.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						add("", name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// receiverName returns the name of the type of a method receiver.
+func receiverName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(expr.X)
+	case *ast.IndexExpr:
+		return receiverName(expr.X)
+	case *ast.IndexListExpr:
+		return receiverName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	}
+	return fmt.Sprintf("%s", expr)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // Stringify returns a string that is all of the enum value names concatenated without a separator
@@ -141,3 +142,91 @@ func DirectValue(enumType string, val EnumValue) (strResult string) {
 		return strconv.FormatInt(val.ValueInt.(int64), 10)
 	}
 }
+
+// ordinals returns the values of the enum in declaration order, without the skipped placeholders,
+// so the index of each value is its ordinal.
+func ordinals(e Enum) []EnumValue {
+	values := make([]EnumValue, 0, len(e.Values))
+	for _, val := range e.Values {
+		if val.Name != skipHolder {
+			values = append(values, val)
+		}
+	}
+	return values
+}
+
+// matchParam returns the name of the Match parameter for the value.  It is built from the
+// sanitized constant name without the enum prefix, so it is a valid identifier whatever the
+// raw name holds, and it is camel cased like the constant.
+func matchParam(e Enum, v EnumValue) string {
+	suffix := v.PrefixedName
+	prefix := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' {
+			return r
+		}
+		return -1
+	}, e.Prefix)
+	for _, p := range []string{prefix, snakeToCamelCase(prefix)} {
+		if p != "" && len(suffix) > len(p) && strings.HasPrefix(suffix, p) {
+			suffix = strings.TrimPrefix(suffix, p)
+			break
+		}
+	}
+	return "on" + snakeToCamelCase(suffix)
+}
+
+// bitSize returns the bit size argument of strconv.ParseInt and strconv.ParseUint for an integer type.
+func bitSize(typ string) string {
+	switch strings.TrimPrefix(typ, "u") {
+	case "int8", "byte":
+		return "8"
+	case "int16":
+		return "16"
+	case "int32", "rune":
+		return "32"
+	case "int64":
+		return "64"
+	}
+	return "strconv.IntSize"
+}
+
+// namedValue is a declared value with the name returned by its String method.
+type namedValue struct {
+	Value EnumValue
+	Name  string
+}
+
+// namedValues returns the declared values of the enum in declaration order, with their names.
+func namedValues(e Enum, forceLower, forceUpper bool) []namedValue {
+	names := stringNames(e, forceLower, forceUpper)
+	values := make([]namedValue, 0, len(names))
+	for i, val := range ordinals(e) {
+		values = append(values, namedValue{Value: val, Name: names[i]})
+	}
+	return values
+}
+
+// maxBitsetValue is the largest value that fits in the uint64 backing a bitset.
+const maxBitsetValue = 63
+
+// fitsBitset reports whether every value of an integer enum can be a bit in a uint64.
+func fitsBitset(e Enum) bool {
+	if e.Type == "string" {
+		return false
+	}
+	for _, val := range ordinals(e) {
+		switch v := val.ValueInt.(type) {
+		case int64:
+			if v < 0 || v > maxBitsetValue {
+				return false
+			}
+		case uint64:
+			if v > maxBitsetValue {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
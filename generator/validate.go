@@ -0,0 +1,111 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"go/version"
+	"strings"
+)
+
+// ConfigError is a combination of options that conflict, or an option that has no effect with the
+// others or on an enum.
+type ConfigError struct {
+	// Options are the names of the GeneratorConfig fields involved.
+	Options []string
+	// Enum is the name of the enum the options don't apply to, or empty when they conflict with
+	// each other.
+	Enum string
+	// Reason tells why the options don't work together.
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	if e.Enum != "" {
+		return fmt.Sprintf("invalid options %s for enum %s: %s", strings.Join(e.Options, ", "), e.Enum, e.Reason)
+	}
+	return fmt.Sprintf("invalid options %s: %s", strings.Join(e.Options, ", "), e.Reason)
+}
+
+// Validate reports every option that conflicts with another or has no effect, each as a *ConfigError
+// joined with errors.Join, or nil when the configuration is valid.
+func (c GeneratorConfig) Validate() error {
+	var errs []error
+	invalid := func(reason string, options ...string) {
+		errs = append(errs, &ConfigError{Options: options, Reason: reason})
+	}
+
+	if c.ForceLower && c.ForceUpper {
+		invalid("the names can't be forced to both lower and upper case", "ForceLower", "ForceUpper")
+	}
+	if c.NoParse && c.MustParse {
+		invalid("MustParse requires the Parse method to exist", "NoParse", "MustParse")
+	}
+	if c.OpenEnum && c.DefaultFallback {
+		invalid("unknown values can't be both preserved and replaced with the default", "OpenEnum", "DefaultFallback")
+	}
+	if c.OrdinalWrap && !c.Ordinal {
+		invalid("OrdinalWrap changes the Next and Prev methods that Ordinal adds", "OrdinalWrap")
+	}
+
+	// These options only change the parse function, which isn't generated at all when it isn't
+	// public and nothing decodes with it
+	if c.NoParse && !c.MustParse && !c.Marshal && !c.anySQLEnabled() && !c.Flag {
+		parseOnly := []struct {
+			name string
+			set  bool
+		}{
+			{"CaseInsensitive", c.CaseInsensitive},
+			{"TypedErrors", c.TypedErrors},
+			{"Suggest", c.Suggest},
+			{"Normalize", c.Normalize || c.Normalizer != ""},
+			{"DefaultFallback", c.DefaultFallback},
+		}
+		for _, option := range parseOnly {
+			if option.set {
+				invalid(option.name+" only changes parsing, and no parse function is generated without Marshal, SQL, Flag or MustParse", "NoParse", option.name)
+			}
+		}
+	}
+
+	if c.Normalizer != "" && !token.IsIdentifier(c.Normalizer) {
+		invalid(fmt.Sprintf("%q is not the name of a function of the package", c.Normalizer), "Normalizer")
+	}
+	if c.GoVersion != "" && !version.IsValid("go"+strings.TrimPrefix(c.GoVersion, "go")) {
+		invalid(fmt.Sprintf("%q is not a go version", c.GoVersion), "GoVersion")
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateEnum reports the options that have no effect on the enum, like SQLInt on an enum that isn't
+// a string, each as a *ConfigError joined with errors.Join, or nil when all of them apply.
+func (c GeneratorConfig) ValidateEnum(enum *Enum) error {
+	var errs []error
+	for _, err := range c.enumConfigErrors(enum) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// warn passes the options that have no effect to the Warn callback, when there is one.
+func (c GeneratorConfig) warn(err *ConfigError) {
+	if c.Warn != nil {
+		c.Warn(err)
+	}
+}
+
+// enumConfigErrors returns the options that have no effect on the enum.
+func (c GeneratorConfig) enumConfigErrors(enum *Enum) []*ConfigError {
+	var errs []*ConfigError
+	invalid := func(reason string, options ...string) {
+		errs = append(errs, &ConfigError{Options: options, Enum: enum.Name, Reason: reason})
+	}
+
+	if c.SQLInt && enum.Type != "string" {
+		invalid(fmt.Sprintf("SQLInt stores string enums as integers, and %s enums are stored as integers already", enum.Type), "SQLInt")
+	}
+	if c.DefaultFallback && enum.DefaultValue() == nil {
+		invalid("there is no value marked with [default] to fall back to", "DefaultFallback")
+	}
+	return errs
+}
@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"bufio"
+	"go/ast"
+	"go/version"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// genericsVersion is the first go version with type parameters.
+	genericsVersion = "go1.18"
+	// fuzzVersion is the first go version with native fuzzing.
+	fuzzVersion = "go1.18"
+	// iteratorVersion is the first go version with range over func iterators and the iter package.
+	iteratorVersion = "go1.23"
+)
+
+// targetGoVersion returns the go version the code generated for filename has to build with.  It is
+// GoVersion when set, otherwise the go directive of the closest go.mod, or empty when neither is known.
+func (g *Generator) targetGoVersion(filename string) string {
+	if g.GoVersion != "" {
+		return g.GoVersion
+	}
+	return moduleGoVersion(filepath.Dir(filename))
+}
+
+// fileGoVersion returns the go version the code generated for the parsed file has to build with.
+func (g *Generator) fileGoVersion(f *ast.File) string {
+	return g.targetGoVersion(g.fileSet.Position(f.Pos()).Filename)
+}
+
+// goVersionAtLeast reports whether v is minVersion or newer.  An unknown version is assumed to be new enough.
+func goVersionAtLeast(v, minVersion string) bool {
+	if v == "" {
+		return true
+	}
+	return version.Compare("go"+strings.TrimPrefix(v, "go"), minVersion) >= 0
+}
+
+// moduleGoVersion returns the go directive of the go.mod in dir or any of its parents, or an
+// empty string if there isn't one.
+func moduleGoVersion(dir string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		if f, err := os.Open(filepath.Join(dir, "go.mod")); err == nil {
+			defer f.Close()
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				fields := strings.Fields(scanner.Text())
+				if len(fields) == 2 && fields[0] == "go" {
+					return fields[1]
+				}
+			}
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
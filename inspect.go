@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/abice/go-enum/generator"
+	"github.com/labstack/gommon/color"
+	"github.com/urfave/cli/v2"
+)
+
+type inspectT struct {
+	NoPrefix       bool
+	Prefix         string
+	LeaveSnakeCase bool
+	Aliases        cli.StringSlice
+}
+
+// inspectedFile is the JSON written for each file by the inspect command.
+type inspectedFile struct {
+	File  string            `json:"file"`
+	Enums []*generator.Enum `json:"enums"`
+}
+
+// inspectCommand writes the enums parsed from the files as JSON, to debug the ENUM declarations or to
+// use them in other tools.
+func inspectCommand(stdout io.Writer) *cli.Command {
+	var argv inspectT
+	return &cli.Command{
+		Name:      "inspect",
+		Usage:     "Prints the enums parsed from the files as JSON, with the names, values, comments and positions the templates get",
+		ArgsUsage: "file.go [file.go...]",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:        "noprefix",
+				Usage:       "Use this if the enums are generated with --noprefix.",
+				Destination: &argv.NoPrefix,
+			},
+			&cli.StringFlag{
+				Name:        "prefix",
+				Usage:       "Use this if the enums are generated with --prefix.",
+				Destination: &argv.Prefix,
+			},
+			&cli.BoolFlag{
+				Name:        "nocamel",
+				Usage:       "Use this if the enums are generated with --nocamel.",
+				Destination: &argv.LeaveSnakeCase,
+			},
+			&cli.StringSliceFlag{
+				Name:        "alias",
+				Aliases:     []string{"a"},
+				Usage:       "Use this if the enums are generated with --alias.",
+				Destination: &argv.Aliases,
+			},
+		},
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() == 0 {
+				return fmt.Errorf("no files to inspect")
+			}
+			aliases, err := generator.ParseAliases(argv.Aliases.Value())
+			if err != nil {
+				return err
+			}
+			g := generator.NewGeneratorWithConfig(generator.GeneratorConfig{
+				NoPrefix:         argv.NoPrefix,
+				Prefix:           argv.Prefix,
+				LeaveSnakeCase:   argv.LeaveSnakeCase,
+				ReplacementNames: aliases,
+			})
+
+			files := []inspectedFile{}
+			for _, fileOption := range ctx.Args().Slice() {
+				filenames, err := globFilenames(fileOption)
+				if err != nil {
+					return err
+				}
+				for _, fileName := range filenames {
+					enums, err := g.InspectFromFile(fileName)
+					if err != nil {
+						return fmt.Errorf("failed inspecting enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+					}
+					files = append(files, inspectedFile{File: fileName, Enums: enums})
+				}
+			}
+
+			enc := json.NewEncoder(stdout)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			return enc.Encode(files)
+		},
+	}
+}
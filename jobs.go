@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// runJobs runs the tasks, at most jobs of them at a time.  What each task writes to stdout is buffered
+// and written in the order of the tasks, so the output doesn't depend on which task finishes first.
+// After a task fails the tasks that didn't start are skipped, and the errors are returned in the
+// order of the tasks.
+func runJobs(jobs int, stdout io.Writer, tasks []func(stdout io.Writer) error) error {
+	if jobs < 1 {
+		jobs = 1
+	}
+
+	outputs := make([]bytes.Buffer, len(tasks))
+	errs := make([]error, len(tasks))
+	done := make([]chan struct{}, len(tasks))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var failed atomic.Bool
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(jobs, len(tasks)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if !failed.Load() {
+					if errs[i] = tasks[i](&outputs[i]); errs[i] != nil {
+						failed.Store(true)
+					}
+				}
+				close(done[i])
+			}
+		}()
+	}
+	go func() {
+		for i := range tasks {
+			next <- i
+		}
+		close(next)
+	}()
+
+	for i := range tasks {
+		<-done[i]
+		_, _ = stdout.Write(outputs[i].Bytes())
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -1,16 +1,24 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
+	"io"
 	"log"
+	"maps"
 	"os"
 	"path/filepath"
+	"runtime"
 	"runtime/debug"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/abice/go-enum/generator"
 	"github.com/labstack/gommon/color"
+	"github.com/mattn/go-colorable"
 	"github.com/urfave/cli/v2"
 )
 
@@ -48,6 +56,35 @@ type rootT struct {
 	ForceUpper        bool
 	NoComments        bool
 	NoParse           bool
+	OpenEnum          bool
+	DefaultFallback   bool
+	FastLookup        bool
+	Benchmark         bool
+	Tests             bool
+	TypedErrors       bool
+	Suggest           bool
+	Normalize         bool
+	Normalizer        string
+	Ordinal           bool
+	OrdinalWrap       bool
+	Iterators         bool
+	GoVersion         string
+	Set               bool
+	EnumMap           bool
+	Match             bool
+	Display           bool
+	Validator         bool
+	ValidateMethod    bool
+	Guard             bool
+	Check             bool
+	PackageFile       string
+	Clean             bool
+	Output            string
+	DryRun            bool
+	Diff              bool
+	Jobs              int
+	CacheDir          string
+	Cache             bool
 	OutputSuffix      string
 }
 
@@ -82,7 +119,9 @@ func main() {
 
 	initializeVersion()
 
+	// The progress messages go to stderr, so the generated code and diffs on stdout can be piped
 	clr := color.New()
+	clr.SetOutput(colorable.NewColorableStderr())
 	out := func(format string, args ...any) {
 		_, _ = fmt.Fprintf(clr.Output(), format, args...)
 	}
@@ -91,14 +130,18 @@ func main() {
 		Name:            "go-enum",
 		Usage:           "An enum generator for go",
 		HideHelpCommand: true,
+		ArgsUsage:       "[packages]",
 		Version:         version,
+		Commands: []*cli.Command{
+			extractCommand(out),
+			inspectCommand(os.Stdout),
+		},
 		Flags: []cli.Flag{
 			&cli.StringSliceFlag{
 				Name:        "file",
 				Aliases:     []string{"f"},
 				EnvVars:     []string{"GOFILE"},
 				Usage:       "The file(s) to generate enums.  Use more than one flag for more files.",
-				Required:    true,
 				Destination: &argv.FileNames,
 			},
 			&cli.BoolFlag{
@@ -113,7 +156,7 @@ func main() {
 			},
 			&cli.BoolFlag{
 				Name:        "nocase",
-				Usage:       "Adds case insensitive parsing to the enumeration (forces lower flag).",
+				Usage:       "Adds case insensitive parsing to the enumeration, without allocating.",
 				Destination: &argv.NoCase,
 			},
 			&cli.BoolFlag{
@@ -229,20 +272,227 @@ func main() {
 				Usage:       "Disables the use of iota in generated enums.",
 				Destination: &argv.NoIota,
 			},
+			&cli.BoolFlag{
+				Name:        "open",
+				Usage:       "Preserves unknown values when unmarshalling or scanning instead of returning an error, and adds an IsKnown method.",
+				Destination: &argv.OpenEnum,
+			},
+			&cli.BoolFlag{
+				Name:        "default-fallback",
+				Usage:       "Falls back to the value marked with [default] instead of returning an error when unmarshalling, scanning or setting an empty or invalid value.",
+				Destination: &argv.DefaultFallback,
+			},
+			&cli.BoolFlag{
+				Name:        "fast",
+				Usage:       "Generates String and Parse methods that use arrays, switches or a perfect hash instead of map lookups.",
+				Destination: &argv.FastLookup,
+			},
+			&cli.BoolFlag{
+				Name:        "benchmark",
+				Usage:       "Generates a _bench_test.go file next to the output that benchmarks the String and Parse methods against map lookups.",
+				Destination: &argv.Benchmark,
+			},
+			&cli.BoolFlag{
+				Name:        "tests",
+				Usage:       "Generates an _enum_test.go file next to the output with round trip tests and fuzz targets for the enabled features.",
+				Destination: &argv.Tests,
+			},
+			&cli.BoolFlag{
+				Name:        "typed-errors",
+				Usage:       "Returns an Invalid{{ENUM}}Error holding the rejected input and the valid names from Parse.",
+				Destination: &argv.TypedErrors,
+			},
+			&cli.BoolFlag{
+				Name:        "suggest",
+				Usage:       "Adds a \"did you mean\" suggestion to the typed parse errors (implies --typed-errors).",
+				Destination: &argv.Suggest,
+			},
+			&cli.BoolFlag{
+				Name:        "normalize",
+				Usage:       "Makes Parse ignore case and the '-', '_', '.' and ' ' separators.",
+				Destination: &argv.Normalize,
+			},
+			&cli.StringFlag{
+				Name:        "normalizer",
+				Usage:       "Name of a func(string) string in the enum's package to normalize names and Parse input with, instead of the built in normalizer (implies --normalize).",
+				Destination: &argv.Normalizer,
+			},
+			&cli.BoolFlag{
+				Name:        "ordinal",
+				Usage:       "Adds Ordinal, Next, Prev and Compare methods based on declaration order, along with Min, Max and Count constants.",
+				Destination: &argv.Ordinal,
+			},
+			&cli.BoolFlag{
+				Name:        "ordinal-wrap",
+				Usage:       "Makes Next and Prev wrap around at the ends of the enum (implies --ordinal).",
+				Destination: &argv.OrdinalWrap,
+			},
+			&cli.BoolFlag{
+				Name:        "iter",
+				Usage:       "Adds {{ENUM}}All and {{ENUM}}Entries functions returning iter.Seq iterators.  Skipped when the module is older than go1.23.",
+				Destination: &argv.Iterators,
+			},
+			&cli.StringFlag{
+				Name:        "go-version",
+				Usage:       "The go version to generate code for, instead of the go directive of the closest go.mod.",
+				Destination: &argv.GoVersion,
+			},
+			&cli.BoolFlag{
+				Name:        "set",
+				Usage:       "Adds a {{ENUM}}Set type, backed by a bitset when the values fit, that marshals as a list of names.",
+				Destination: &argv.Set,
+			},
+			&cli.BoolFlag{
+				Name:        "enum-map",
+				Usage:       "Adds a generic {{ENUM}}Map[V] type backed by an array indexed by ordinal, that marshals as an object keyed by name.",
+				Destination: &argv.EnumMap,
+			},
+			&cli.BoolFlag{
+				Name:        "match",
+				Usage:       "Adds a Match method and a generic Match{{ENUM}} function, that take a function for every value so adding a value breaks every call.",
+				Destination: &argv.Match,
+			},
+			&cli.BoolFlag{
+				Name:        "display",
+				Usage:       "Adds a Display(language.Tag) method, that translates the name with the default golang.org/x/text message catalog.",
+				Destination: &argv.Display,
+			},
+			&cli.BoolFlag{
+				Name:        "validator",
+				Usage:       "Adds a Register{{ENUM}}Validation function, that registers a tag named after the enum with github.com/go-playground/validator.",
+				Destination: &argv.Validator,
+			},
+			&cli.BoolFlag{
+				Name:        "validate",
+				Usage:       "Adds a Validate method, that satisfies the Validatable interface of ozzo-validation.",
+				Destination: &argv.ValidateMethod,
+			},
+			&cli.BoolFlag{
+				Name:        "guard",
+				Usage:       "Adds a function that fails to compile when the constants are edited without running go-enum, and the hash of the ENUM declaration.",
+				Destination: &argv.Guard,
+			},
+			&cli.BoolFlag{
+				Name:        "check",
+				Usage:       "Checks that the generated files are up to date instead of writing them, printing a diff and failing for each one that is not.",
+				Destination: &argv.Check,
+			},
+			&cli.StringFlag{
+				Name:        "package-file",
+				Usage:       "Writes the enums of each package to a single file with this name, like enums_gen.go, instead of a file for each source file.",
+				Destination: &argv.PackageFile,
+			},
+			&cli.BoolFlag{
+				Name:        "clean",
+				Usage:       "Removes the files generated for each source file before, when writing a single file for each package with --package-file.",
+				Destination: &argv.Clean,
+			},
+			&cli.StringFlag{
+				Name:        "output",
+				Aliases:     []string{"o"},
+				Usage:       "Writes the generated code to this file instead of next to the input, or to stdout with -.  A file needs a single input file.",
+				Destination: &argv.Output,
+			},
+			&cli.BoolFlag{
+				Name:        "dry-run",
+				Usage:       "Prints the files that would change instead of writing them.",
+				Destination: &argv.DryRun,
+			},
+			&cli.BoolFlag{
+				Name:        "diff",
+				Usage:       "Prints a unified diff of the files that would change instead of writing them.",
+				Destination: &argv.Diff,
+			},
+			&cli.IntFlag{
+				Name:        "jobs",
+				Aliases:     []string{"j"},
+				Usage:       "The number of files generated at the same time, the number of CPUs when 0.",
+				Destination: &argv.Jobs,
+			},
+			&cli.BoolFlag{
+				Name:        "cache",
+				Usage:       "Skips the files that didn't change since they were generated, using a cache in the go-enum directory of the user cache directory.",
+				Destination: &argv.Cache,
+			},
+			&cli.StringFlag{
+				Name:        "cache-dir",
+				Usage:       "Turns on the cache, storing it in this directory instead of the user cache directory.",
+				Destination: &argv.CacheDir,
+			},
 		},
 		Action: func(ctx *cli.Context) error {
-			// Validate incompatible flag combinations
-			if argv.NoParse && argv.MustParse {
-				return fmt.Errorf("--noparse and --mustparse are incompatible: MustParse requires the Parse method to exist")
+			// The file is required here rather than on the flag, so the subcommands and the package
+			// patterns don't need it
+			if len(argv.FileNames.Value()) == 0 && ctx.NArg() == 0 {
+				return fmt.Errorf("Required flag %q not set", "file")
 			}
-
 			aliases, err := generator.ParseAliases(argv.Aliases.Value())
 			if err != nil {
 				return err
 			}
-			for _, fileOption := range argv.FileNames.Value() {
 
-				// Build configuration structure
+			if argv.Output != "" && argv.Output != "-" && (argv.PackageFile != "" || ctx.NArg() > 0) {
+				return fmt.Errorf("--output needs a single input file, use - to print the code of packages")
+			}
+
+			writing := !argv.Check && !argv.DryRun && !argv.Diff && argv.Output != "-"
+
+			// The cache skips the files read here that didn't change since they were written.  The files
+			// of packages aren't cached, as their code also depends on the types of the other files.
+			// Without a cache directory, the files are generated without it.
+			var cache *buildCache
+			if writing && (argv.Cache || argv.CacheDir != "") {
+				var err error
+				if cache, err = newBuildCache(argv.CacheDir); err != nil {
+					out(color.Yellow("go-enum cache disabled: %s\n"), err)
+					cache = nil
+				}
+			}
+
+			// writeOutput writes the generated code to path, unless it is printed, diffed or checked
+			// instead.  A nil raw removes the file, and a file that is up to date isn't written again,
+			// so its modification time doesn't change.
+			var mu sync.Mutex
+			var outdated []string
+			writeOutput := func(stdout io.Writer, path string, raw []byte) error {
+				if argv.Output == "-" {
+					_, err := stdout.Write(raw)
+					return err
+				}
+				if writing {
+					if raw == nil {
+						if err := os.Remove(path); err != nil {
+							return fmt.Errorf("failed removing file %s: %s", color.Cyan(path), color.Red(err))
+						}
+						out("go-enum removed. file: %s\n", color.Cyan(path))
+						return nil
+					}
+					if err := writeIfChanged(path, raw); err != nil {
+						return fmt.Errorf("failed writing to file %s: %s", color.Cyan(path), color.Red(err))
+					}
+					return nil
+				}
+
+				diff, err := outputDiff(path, raw)
+				if err != nil || diff == "" {
+					return err
+				}
+				if argv.Check {
+					mu.Lock()
+					outdated = append(outdated, path)
+					mu.Unlock()
+				}
+				if argv.DryRun {
+					fmt.Fprintln(stdout, path)
+				}
+				if argv.Check || argv.Diff {
+					fmt.Fprint(stdout, diff)
+				}
+				return nil
+			}
+
+			// newGenerator creates a generator with the configuration of the flags
+			newGenerator := func() (*generator.Generator, error) {
 				jsonPkg := argv.JsonPkg
 				if jsonPkg == "" {
 					jsonPkg = "encoding/json"
@@ -252,7 +502,7 @@ func main() {
 				if templates := []string(argv.TemplateFileNames.Value()); len(templates) > 0 {
 					for _, t := range templates {
 						if fn, err := globFilenames(t); err != nil {
-							return err
+							return nil, err
 						} else {
 							templateFileNames = append(templateFileNames, fn...)
 						}
@@ -262,7 +512,7 @@ func main() {
 				config := generator.GeneratorConfig{
 					NoPrefix:          argv.NoPrefix,
 					NoIota:            argv.NoIota,
-					LowercaseLookup:   argv.Lowercase || argv.NoCase,
+					LowercaseLookup:   argv.Lowercase,
 					CaseInsensitive:   argv.NoCase,
 					Marshal:           argv.Marshal,
 					SQL:               argv.SQL,
@@ -281,62 +531,321 @@ func main() {
 					ForceUpper:        argv.ForceUpper,
 					NoComments:        argv.NoComments,
 					NoParse:           argv.NoParse,
+					OpenEnum:          argv.OpenEnum,
+					DefaultFallback:   argv.DefaultFallback,
+					FastLookup:        argv.FastLookup,
+					TypedErrors:       argv.TypedErrors || argv.Suggest,
+					Suggest:           argv.Suggest,
+					Normalize:         argv.Normalize || argv.Normalizer != "",
+					Normalizer:        argv.Normalizer,
+					Ordinal:           argv.Ordinal || argv.OrdinalWrap,
+					OrdinalWrap:       argv.OrdinalWrap,
+					Iterators:         argv.Iterators,
+					GoVersion:         argv.GoVersion,
+					Set:               argv.Set,
+					EnumMap:           argv.EnumMap,
+					Match:             argv.Match,
+					Display:           argv.Display,
+					Validator:         argv.Validator,
+					ValidateMethod:    argv.ValidateMethod,
+					Guard:             argv.Guard,
 					BuildTags:         argv.BuildTags.Value(),
 					ReplacementNames:  aliases,
 					TemplateFileNames: templateFileNames,
+					Warn: func(err *generator.ConfigError) {
+						out(color.Yellow("go-enum warning: %s\n"), flagMessage(err))
+					},
 				}
 
 				// Create generator with configuration
-				g := generator.NewGeneratorWithConfig(config)
+				g, err := generator.NewGeneratorWithConfigE(config)
+				if err != nil {
+					return nil, flagErrors(err)
+				}
 				g.Version = version
 				g.Revision = commit
 				g.BuildDate = date
 				g.BuiltBy = builtBy
+				return g, nil
+			}
 
-				var filenames []string
-				if fn, err := globFilenames(fileOption); err != nil {
+			outputSuffix := `_enum`
+			if argv.OutputSuffix != "" {
+				outputSuffix = argv.OutputSuffix
+			}
+
+			// processFile generates the code for a file, which is parsed here when f is nil.
+			var outputs int
+			processFile := func(g *generator.Generator, stdout io.Writer, originalName, fileName string, f *ast.File) error {
+				out("go-enum started. file: %s\n", color.Cyan(originalName))
+				fileName, _ = filepath.Abs(fileName)
+
+				outFilePath := outputFilePath(fileName, outputSuffix)
+				if argv.Output != "" && argv.Output != "-" {
+					mu.Lock()
+					outputs++
+					n := outputs
+					mu.Unlock()
+					if n > 1 {
+						return fmt.Errorf("--output needs a single input file, use - to print the code of several files")
+					}
+					outFilePath, _ = filepath.Abs(argv.Output)
+				}
+
+				var cacheKey string
+				written := make(map[string][]byte)
+				if cache != nil && f == nil {
+					src, err := os.ReadFile(fileName)
+					if err != nil {
+						return fmt.Errorf("failed reading file %s: %s", color.Cyan(fileName), color.Red(err))
+					}
+					key, err := g.CacheKey(fileName, src)
+					if err != nil {
+						return err
+					}
+					cacheKey = cache.key(key, outFilePath, strconv.FormatBool(argv.Benchmark), strconv.FormatBool(argv.Tests))
+					if cache.fresh(cacheKey) {
+						out("go-enum cached. file: %s\n", color.Cyan(originalName))
+						return nil
+					}
+				}
+				write := func(path string, raw []byte) error {
+					written[path] = raw
+					return writeOutput(stdout, path, raw)
+				}
+
+				// Parse the file given in arguments, unless it was loaded with its package
+				raw, err := generateFile(f, fileName, g.Generate, g.GenerateFromFile)
+				if err != nil {
+					return fmt.Errorf("failed generating enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+				}
+
+				// Nothing was generated, ignore the output and don't create a file.
+				if len(raw) < 1 {
+					out(color.Yellow("go-enum ignored. file: %s\n"), color.Cyan(originalName))
+					if cacheKey != "" {
+						return cache.store(cacheKey, written)
+					}
+					return nil
+				}
+
+				if err := write(outFilePath, raw); err != nil {
 					return err
+				}
+
+				if argv.Benchmark {
+					benchRaw, err := generateFile(f, fileName, g.GenerateBenchmark, g.GenerateBenchmarkFromFile)
+					if err != nil {
+						return fmt.Errorf("failed generating benchmarks\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+					}
+					if err := write(benchmarkFilePath(outFilePath), benchRaw); err != nil {
+						return err
+					}
+				}
+				if argv.Tests {
+					testRaw, err := generateFile(f, fileName, g.GenerateTests, g.GenerateTestsFromFile)
+					if err != nil {
+						return fmt.Errorf("failed generating tests\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+					}
+					if err := write(testsFilePath(outFilePath), testRaw); err != nil {
+						return err
+					}
+				}
+				if cacheKey != "" {
+					if err := cache.store(cacheKey, written); err != nil {
+						return err
+					}
+				}
+				if !writing {
+					out("go-enum checked. file: %s\n", color.Cyan(originalName))
 				} else {
-					filenames = fn
+					out("go-enum finished. file: %s\n", color.Cyan(originalName))
 				}
+				return nil
+			}
 
-				outputSuffix := `_enum`
-				if argv.OutputSuffix != "" {
-					outputSuffix = argv.OutputSuffix
+			// processPackage generates a single file for the enums of a package.
+			processPackage := func(g *generator.Generator, stdout io.Writer, p *packageSources) error {
+				originalName := p.Dir
+				if wd, err := os.Getwd(); err == nil {
+					if rel, err := filepath.Rel(wd, p.Dir); err == nil {
+						originalName = rel
+					}
+				}
+				if p.Test {
+					originalName += " (test files)"
 				}
+				out("go-enum started. package: %s\n", color.Cyan(originalName))
 
-				for _, fileName := range filenames {
-					originalName := fileName
+				outFilePath := p.outputPath(argv.PackageFile)
+				outputs := make(map[string]bool)
+				for _, path := range packageOutputs(outFilePath) {
+					outputs[path] = true
+				}
+				// The files generated for each source file before declare the same identifiers
+				stale, err := p.staleOutputs(outputSuffix, outputs)
+				if err != nil {
+					return err
+				}
+				if len(stale) > 0 && !argv.Clean {
+					return fmt.Errorf("failed generating enums\nPackage=%s\nError=%s", color.Cyan(p.Dir),
+						color.RedBg(fmt.Errorf("the files generated for each source file would declare the enums twice, remove them or use --clean: %s", strings.Join(stale, ", "))))
+				}
+
+				// The generated identifiers are checked against every file of the package, except the
+				// outputs of the test files, or of the other files, which may be written at the same time
+				skip := maps.Clone(outputs)
+				for _, path := range stale {
+					skip[path] = true
+				}
+				for _, path := range packageOutputs((&packageSources{Dir: p.Dir, Test: !p.Test}).outputPath(argv.PackageFile)) {
+					skip[path] = true
+				}
+				siblings, err := p.siblingFilenames(skip)
+				if err != nil {
+					return err
+				}
+				siblingFiles, err := g.ParseFiles(siblings...)
+				if err != nil {
+					return err
+				}
+				files := append(slices.Clip(p.Files), siblingFiles...)
 
-					out("go-enum started. file: %s\n", color.Cyan(originalName))
-					fileName, _ = filepath.Abs(fileName)
+				raw, err := g.GeneratePackage(files)
+				if err != nil {
+					return fmt.Errorf("failed generating enums\nPackage=%s\nError=%s", color.Cyan(p.Dir), color.RedBg(err))
+				}
 
-					outFilePath := fmt.Sprintf("%s%s.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)), outputSuffix)
-					if strings.HasSuffix(fileName, "_test.go") {
-						outFilePath = strings.Replace(outFilePath, "_test"+outputSuffix+".go", outputSuffix+"_test.go", 1)
+				// Nothing was generated, ignore the output and don't create a file.
+				if len(raw) < 1 {
+					out(color.Yellow("go-enum ignored. package: %s\n"), color.Cyan(originalName))
+					return nil
+				}
+
+				if err := writeOutput(stdout, outFilePath, raw); err != nil {
+					return err
+				}
+
+				// The benchmarks and tests of the test files would need files of their own
+				if argv.Benchmark && !p.Test {
+					benchRaw, err := g.GeneratePackageBenchmark(files)
+					if err != nil {
+						return fmt.Errorf("failed generating benchmarks\nPackage=%s\nError=%s", color.Cyan(p.Dir), color.RedBg(err))
+					}
+					if err := writeOutput(stdout, benchmarkFilePath(outFilePath), benchRaw); err != nil {
+						return err
+					}
+				}
+				if argv.Tests && !p.Test {
+					testRaw, err := g.GeneratePackageTests(files)
+					if err != nil {
+						return fmt.Errorf("failed generating tests\nPackage=%s\nError=%s", color.Cyan(p.Dir), color.RedBg(err))
+					}
+					if err := writeOutput(stdout, strings.TrimSuffix(outFilePath, ".go")+"_roundtrip_test.go", testRaw); err != nil {
+						return err
 					}
+				}
+
+				for _, path := range stale {
+					if err := writeOutput(stdout, path, nil); err != nil {
+						return err
+					}
+				}
+
+				if !writing {
+					out("go-enum checked. package: %s\n", color.Cyan(originalName))
+				} else {
+					out("go-enum finished. package: %s\n", color.Cyan(originalName))
+				}
+				return nil
+			}
+
+			// A single generator is shared by the files, so the templates are parsed once
+			g, err := newGenerator()
+			if err != nil {
+				return err
+			}
 
-					// Parse the file given in arguments
-					raw, err := g.GenerateFromFile(fileName)
+			var tasks []func(stdout io.Writer) error
+			if argv.PackageFile != "" {
+				var filenames []string
+				var files []*ast.File
+				for _, fileOption := range argv.FileNames.Value() {
+					fn, err := globFilenames(fileOption)
 					if err != nil {
-						return fmt.Errorf("failed generating enums\nInputFile=%s\nError=%s", color.Cyan(fileName), color.RedBg(err))
+						return err
+					}
+					for _, fileName := range fn {
+						fileName, _ = filepath.Abs(fileName)
+						parsed, err := g.ParseFiles(fileName)
+						if err != nil {
+							return err
+						}
+						filenames = append(filenames, fileName)
+						files = append(files, parsed...)
+					}
+				}
+				if ctx.NArg() > 0 {
+					loaded, err := g.LoadPackages(ctx.Args().Slice()...)
+					if err != nil {
+						return err
+					}
+					for _, file := range loaded {
+						filenames = append(filenames, file.Filename)
+						files = append(files, file.Syntax)
+					}
+				}
+				for _, p := range groupSources(filenames, files) {
+					tasks = append(tasks, func(stdout io.Writer) error {
+						return processPackage(g, stdout, p)
+					})
+				}
+			} else {
+				for _, fileOption := range argv.FileNames.Value() {
+					var filenames []string
+					if fn, err := globFilenames(fileOption); err != nil {
+						return err
+					} else {
+						filenames = fn
 					}
 
-					// Nothing was generated, ignore the output and don't create a file.
-					if len(raw) < 1 {
-						out(color.Yellow("go-enum ignored. file: %s\n"), color.Cyan(originalName))
-						continue
+					for _, fileName := range filenames {
+						tasks = append(tasks, func(stdout io.Writer) error {
+							return processFile(g, stdout, fileName, fileName, nil)
+						})
 					}
+				}
 
-					mode := int(0o644)
-					err = os.WriteFile(outFilePath, raw, os.FileMode(mode))
+				// The arguments are package patterns, like ./...
+				if ctx.NArg() > 0 {
+					files, err := g.LoadPackages(ctx.Args().Slice()...)
 					if err != nil {
-						return fmt.Errorf("failed writing to file %s: %s", color.Cyan(outFilePath), color.Red(err))
+						return err
+					}
+					wd, _ := os.Getwd()
+					for _, file := range files {
+						originalName := file.Filename
+						if rel, err := filepath.Rel(wd, file.Filename); err == nil {
+							originalName = rel
+						}
+						tasks = append(tasks, func(stdout io.Writer) error {
+							return processFile(g, stdout, originalName, file.Filename, file.Syntax)
+						})
 					}
-					out("go-enum finished. file: %s\n", color.Cyan(originalName))
 				}
 			}
 
+			jobs := argv.Jobs
+			if jobs < 1 {
+				jobs = runtime.GOMAXPROCS(0)
+			}
+			if err := runJobs(jobs, os.Stdout, tasks); err != nil {
+				return err
+			}
+
+			if len(outdated) > 0 {
+				return fmt.Errorf("%d generated file(s) out of date, run go-enum again: %s", len(outdated), strings.Join(outdated, ", "))
+			}
 			return nil
 		},
 	}
@@ -346,6 +855,40 @@ func main() {
 	}
 }
 
+// outputFilePath returns the path of the file generated for a source file.  The output of a test file
+// is a test file as well.
+func outputFilePath(fileName, outputSuffix string) string {
+	outFilePath := fmt.Sprintf("%s%s.go", strings.TrimSuffix(fileName, filepath.Ext(fileName)), outputSuffix)
+	if strings.HasSuffix(fileName, "_test.go") {
+		outFilePath = strings.Replace(outFilePath, "_test"+outputSuffix+".go", outputSuffix+"_test.go", 1)
+	}
+	return outFilePath
+}
+
+// writeIfChanged writes raw to path, unless the file holds it already, so the modification time of
+// the file only changes with its content.
+func writeIfChanged(path string, raw []byte) error {
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, raw) {
+		return nil
+	}
+	return os.WriteFile(path, raw, 0o644)
+}
+
+// benchmarkFilePath returns the path of the benchmarks generated next to an output file.  The output
+// of a _test.go file keeps _test in the name, so it doesn't share the benchmarks of the other file.
+func benchmarkFilePath(outFilePath string) string {
+	return strings.TrimSuffix(outFilePath, ".go") + "_bench_test.go"
+}
+
+// testsFilePath returns the path of the round trip tests generated next to an output file.
+func testsFilePath(outFilePath string) string {
+	if strings.HasSuffix(outFilePath, "_test.go") {
+		// The output of a _test.go file is a test file already
+		return strings.TrimSuffix(outFilePath, "_test.go") + "_roundtrip_test.go"
+	}
+	return strings.TrimSuffix(outFilePath, ".go") + "_test.go"
+}
+
 // globFilenames gets a list of filenames matching the provided filename.
 // In order to maintain existing capabilities, only glob when a * is in the path.
 // Leave execution on par with old method in case there are bad patterns in use that somehow
@@ -361,3 +904,11 @@ func globFilenames(filename string) ([]string, error) {
 		return []string{filename}, nil
 	}
 }
+
+// generateFile generates the code for the parsed file f, or parses fileName when f is nil.
+func generateFile(f *ast.File, fileName string, fromAST func(*ast.File) ([]byte, error), fromFile func(string) ([]byte, error)) ([]byte, error) {
+	if f != nil {
+		return fromAST(f)
+	}
+	return fromFile(fileName)
+}
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -486,7 +493,7 @@ func createCliApp(argv *rootT) *cli.App {
 			},
 			&cli.BoolFlag{
 				Name:        "nocase",
-				Usage:       "Adds case insensitive parsing to the enumeration (forces lower flag).",
+				Usage:       "Adds case insensitive parsing to the enumeration, without allocating.",
 				Destination: &argv.NoCase,
 			},
 			&cli.BoolFlag{
@@ -2128,25 +2135,19 @@ type Number int
 		Name:  "go-enum",
 		Flags: createTestFlags(),
 		Action: func(ctx *cli.Context) error {
-			// Get the argv struct
-			argv := &rootT{
+			// The configuration is validated when the generator is created
+			_, err := generator.NewGeneratorWithConfigE(generator.GeneratorConfig{
 				NoParse:   ctx.Bool("noparse"),
 				MustParse: ctx.Bool("mustparse"),
-			}
-
-			// Validate incompatible flag combinations
-			if argv.NoParse && argv.MustParse {
-				return fmt.Errorf("--noparse and --mustparse are incompatible: MustParse requires the Parse method to exist")
-			}
-
-			return nil
+			})
+			return err
 		},
 	}
 
 	// Test with both flags
 	err = app.Run([]string{"go-enum", "--file", testFile, "--noparse", "--mustparse"})
 	require.Error(t, err, "Expected error when using both --noparse and --mustparse")
-	assert.Contains(t, err.Error(), "incompatible")
+	assert.Contains(t, err.Error(), "invalid options NoParse, MustParse")
 	assert.Contains(t, err.Error(), "MustParse requires the Parse method to exist")
 }
 
@@ -2228,7 +2229,7 @@ type Number int
 
 	// Verify that UnmarshalText uses the unexported parse
 	assert.Contains(t, outputStr, "func (x *Number) UnmarshalText(")
-	assert.Contains(t, outputStr, "parseNumber(name)")
+	assert.Contains(t, outputStr, "parseNumberBytes(text)")
 }
 
 // TestNoParseWithSQL tests that using --noparse with --sql creates unexported parse
@@ -2368,3 +2369,218 @@ func createTestFlags() []cli.Flag {
 		},
 	}
 }
+
+func TestOutputDiff(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "color_enum.go")
+
+	diff, err := outputDiff(path, []byte("package example\n"))
+	require.NoError(t, err)
+	assert.Contains(t, diff, "@@ -0,0 +1 @@\n+package example\n")
+
+	require.NoError(t, os.WriteFile(path, []byte("package example\n"), 0o644))
+	diff, err = outputDiff(path, []byte("package example\n"))
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	diff, err = outputDiff(path, []byte("package example\n\nconst Red = 1\n"))
+	require.NoError(t, err)
+	assert.Contains(t, diff, "--- "+path)
+	assert.Contains(t, diff, "+const Red = 1")
+	assert.NotContains(t, diff, "-package example")
+}
+
+func TestGroupSources(t *testing.T) {
+	filenames := []string{
+		filepath.Join("b", "z.go"),
+		filepath.Join("a", "y_test.go"),
+		filepath.Join("a", "y.go"),
+		filepath.Join("a", "x.go"),
+	}
+	groups := groupSources(filenames, make([]*ast.File, len(filenames)))
+	require.Len(t, groups, 3)
+
+	assert.Equal(t, []string{filepath.Join("a", "x.go"), filepath.Join("a", "y.go")}, groups[0].Filenames)
+	assert.Equal(t, filepath.Join("a", "enums_gen.go"), groups[0].outputPath("enums_gen.go"))
+	assert.Equal(t, []string{filepath.Join("a", "y_test.go")}, groups[1].Filenames)
+	assert.Equal(t, filepath.Join("a", "enums_gen_test.go"), groups[1].outputPath("enums_gen.go"))
+	assert.Equal(t, filepath.Join("b", "enums_gen.go"), groups[2].outputPath("enums_gen.go"))
+}
+
+func TestOutputFilePaths(t *testing.T) {
+	assert.Equal(t, "color_enum.go", outputFilePath("color.go", "_enum"))
+	assert.Equal(t, "color_enum_test.go", outputFilePath("color_test.go", "_enum"))
+	assert.Equal(t, "color_enum_bench_test.go", benchmarkFilePath("color_enum.go"))
+	assert.Equal(t, "color_enum_test_bench_test.go", benchmarkFilePath("color_enum_test.go"))
+	// A file and its test file don't write the same benchmarks
+	assert.NotEqual(t, benchmarkFilePath(outputFilePath("color.go", "_enum")), benchmarkFilePath(outputFilePath("color_test.go", "_enum")))
+	assert.Equal(t, "color_enum_test.go", testsFilePath("color_enum.go"))
+	assert.Equal(t, "color_enum_roundtrip_test.go", testsFilePath("color_enum_test.go"))
+}
+
+func TestIsGeneratedOutput(t *testing.T) {
+	dir := t.TempDir()
+	generated := filepath.Join(dir, "color_enum.go")
+	require.NoError(t, os.WriteFile(generated, []byte("// Code generated by go-enum DO NOT EDIT.\npackage example\n"), 0o644))
+	handwritten := filepath.Join(dir, "color.go")
+	require.NoError(t, os.WriteFile(handwritten, []byte("package example\n"), 0o644))
+
+	for path, want := range map[string]bool{generated: true, handwritten: false, filepath.Join(dir, "missing.go"): false} {
+		got, err := isGeneratedOutput(path)
+		require.NoError(t, err)
+		assert.Equal(t, want, got, path)
+	}
+}
+
+func TestRunJobs(t *testing.T) {
+	var tasks []func(stdout io.Writer) error
+	for i := 0; i < 20; i++ {
+		tasks = append(tasks, func(stdout io.Writer) error {
+			// The first tasks finish last
+			time.Sleep(time.Duration(20-i) * time.Millisecond)
+			fmt.Fprintf(stdout, "%d\n", i)
+			return nil
+		})
+	}
+	var out strings.Builder
+	require.NoError(t, runJobs(4, &out, tasks))
+	var want strings.Builder
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(&want, "%d\n", i)
+	}
+	assert.Equal(t, want.String(), out.String())
+
+	var ran atomic.Int32
+	failing := []func(stdout io.Writer) error{
+		func(stdout io.Writer) error { ran.Add(1); return errors.New("first") },
+		func(stdout io.Writer) error { ran.Add(1); return nil },
+	}
+	err := runJobs(1, io.Discard, failing)
+	assert.EqualError(t, err, "first")
+	assert.Equal(t, int32(1), ran.Load(), "the tasks after a failure are skipped")
+}
+
+func TestBuildCache(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := newBuildCache(filepath.Join(dir, "cache"))
+	require.NoError(t, err)
+
+	output := filepath.Join(dir, "color_enum.go")
+	raw := []byte("package example\n")
+	require.NoError(t, os.WriteFile(output, raw, 0o644))
+
+	key := cache.key("input", output)
+	assert.NotEqual(t, key, cache.key("input", output, "true"))
+	assert.False(t, cache.fresh(key), "nothing is cached yet")
+
+	require.NoError(t, cache.store(key, map[string][]byte{output: raw}))
+	assert.True(t, cache.fresh(key))
+
+	require.NoError(t, os.WriteFile(output, []byte("package edited\n"), 0o644))
+	assert.False(t, cache.fresh(key), "the output was edited")
+
+	require.NoError(t, os.Remove(output))
+	assert.False(t, cache.fresh(key), "the output was removed")
+}
+
+func TestExtractCatalogPackage(t *testing.T) {
+	dir := t.TempDir()
+	enums := filepath.Join(dir, "weather.go")
+	require.NoError(t, os.WriteFile(enums, []byte("package weather\n\n// ENUM(sun, rain)\ntype Weather int\n"), 0o644))
+	// A file of the external test package without enums comes last, and must not name the catalog package
+	external := filepath.Join(dir, "weather_x_test.go")
+	require.NoError(t, os.WriteFile(external, []byte("package weather_test\n"), 0o644))
+
+	catalog := filepath.Join(dir, "catalog.go")
+	app := &cli.App{Commands: []*cli.Command{extractCommand(func(string, ...any) {})}}
+	err := app.Run([]string{"go-enum", "extract", "-f", enums, "-f", external, "--lang", "en", "--out", filepath.Join(dir, "locales"), "--catalog", catalog})
+	require.NoError(t, err)
+
+	raw, err := os.ReadFile(catalog)
+	require.NoError(t, err)
+	assert.Contains(t, string(raw), "package weather\n")
+
+	_, err = catalogPackage([]generator.DisplayMessage{{Package: "a"}, {Package: "b"}, {Package: "a"}})
+	assert.ErrorContains(t, err, "more than one package (a, b)")
+	_, err = catalogPackage(nil)
+	assert.Error(t, err)
+}
+
+func TestPackageSiblings(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, src string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(src), 0o644))
+		return path
+	}
+	color := write("color.go", "package example\n\n// ENUM(red)\ntype Color int\n")
+	helpers := write("helpers.go", "package example\n\nconst ColorRed = 1\n")
+	inPackageTest := write("helpers_test.go", "package example\n")
+	write("external_test.go", "package example_test\n")
+	stale := write("color_enum.go", generatedHeader+" DO NOT EDIT.\npackage example\n")
+	output := write("enums_gen.go", generatedHeader+" DO NOT EDIT.\npackage example\n")
+
+	f, err := parser.ParseFile(token.NewFileSet(), color, nil, parser.PackageClauseOnly)
+	require.NoError(t, err)
+	p := &packageSources{Dir: dir, Filenames: []string{color}, Files: []*ast.File{f}}
+
+	outputs := map[string]bool{output: true}
+	staleFiles, err := p.staleOutputs("_enum", outputs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{stale}, staleFiles)
+
+	// The other files of the package are checked, whether they declare enums or not
+	siblings, err := p.siblingFilenames(map[string]bool{output: true, stale: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{helpers, inPackageTest}, siblings)
+
+	assert.Equal(t, []string{"enums_gen.go", "enums_gen_bench_test.go", "enums_gen_roundtrip_test.go"}, packageOutputs("enums_gen.go"))
+}
+
+func TestWriteIfChanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "color_enum.go")
+	require.NoError(t, writeIfChanged(path, []byte("package a\n")))
+
+	old := time.Now().Add(-time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(path, old, old))
+
+	// The same content leaves the file alone
+	require.NoError(t, writeIfChanged(path, []byte("package a\n")))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.True(t, info.ModTime().Equal(old))
+
+	require.NoError(t, writeIfChanged(path, []byte("package b\n")))
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "package b\n", string(raw))
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.False(t, info.ModTime().Equal(old))
+}
+
+func TestFlagErrors(t *testing.T) {
+	_, err := generator.NewGeneratorWithConfigE(generator.GeneratorConfig{NoParse: true, MustParse: true, TypedErrors: true})
+	require.Error(t, err)
+	assert.EqualError(t, flagErrors(err), "invalid flags --noparse, --mustparse: --mustparse requires the Parse method to exist")
+
+	_, err = generator.NewGeneratorWithConfigE(generator.GeneratorConfig{NoParse: true, TypedErrors: true, ForceLower: true, ForceUpper: true})
+	require.Error(t, err)
+	assert.EqualError(t, flagErrors(err), "invalid flags --forcelower, --forceupper: the names can't be forced to both lower and upper case\n"+
+		"invalid flags --noparse, --typed-errors: --typed-errors only changes parsing, and no parse function is generated without --marshal, --sql, --flag or --mustparse")
+
+	warning := &generator.ConfigError{Options: []string{"SQLInt"}, Enum: "Color", Reason: "SQLInt stores string enums as integers"}
+	assert.Equal(t, "invalid flags --sqlint for enum Color: --sqlint stores string enums as integers", flagMessage(warning))
+
+	// Every field set from a flag has its name
+	typ := reflect.TypeOf(generator.GeneratorConfig{})
+	for i := 0; i < typ.NumField(); i++ {
+		if field := typ.Field(i); field.Type.Kind() != reflect.Func {
+			assert.Contains(t, configFlags, field.Name)
+		}
+	}
+	assert.Len(t, configFlags, typ.NumField()-1)
+
+	// Errors that aren't about the configuration are kept
+	other := errors.New("failed parsing template files")
+	assert.EqualError(t, flagErrors(other), other.Error())
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// generatedHeader starts every file written by go-enum.
+const generatedHeader = "// Code generated by go-enum"
+
+// packageSources are the source files of a package, or of its test files, that are generated into
+// a single file.
+type packageSources struct {
+	Dir       string
+	Test      bool
+	Filenames []string
+	Files     []*ast.File
+}
+
+// outputPath returns the path of the file with the enums of the package, which is a test file for
+// the enums of the test files.
+func (p *packageSources) outputPath(packageFile string) string {
+	if p.Test {
+		return filepath.Join(p.Dir, strings.TrimSuffix(packageFile, ".go")+"_test.go")
+	}
+	return filepath.Join(p.Dir, packageFile)
+}
+
+// groupSources groups the files by directory, keeping the test files apart from the others, sorted by
+// directory and file name.
+func groupSources(filenames []string, files []*ast.File) []*packageSources {
+	groups := make(map[string]*packageSources)
+	for i, filename := range filenames {
+		dir := filepath.Dir(filename)
+		test := strings.HasSuffix(filename, "_test.go")
+		key := fmt.Sprintf("%s\x00%t", dir, test)
+		p, ok := groups[key]
+		if !ok {
+			p = &packageSources{Dir: dir, Test: test}
+			groups[key] = p
+		}
+		p.Filenames = append(p.Filenames, filename)
+		p.Files = append(p.Files, files[i])
+	}
+
+	sorted := make([]*packageSources, 0, len(groups))
+	for _, p := range groups {
+		sort.Sort(byFilename{p})
+		sorted = append(sorted, p)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Dir != sorted[j].Dir {
+			return sorted[i].Dir < sorted[j].Dir
+		}
+		return !sorted[i].Test && sorted[j].Test
+	})
+	return sorted
+}
+
+// packageOutputs returns the paths of the file with the enums of a package, its benchmarks and its
+// round trip tests.
+func packageOutputs(outFilePath string) []string {
+	return []string{outFilePath, benchmarkFilePath(outFilePath), strings.TrimSuffix(outFilePath, ".go") + "_roundtrip_test.go"}
+}
+
+// byFilename sorts the files of a package by name.
+type byFilename struct{ *packageSources }
+
+func (p byFilename) Len() int           { return len(p.Filenames) }
+func (p byFilename) Less(i, j int) bool { return p.Filenames[i] < p.Filenames[j] }
+func (p byFilename) Swap(i, j int) {
+	p.Filenames[i], p.Filenames[j] = p.Filenames[j], p.Filenames[i]
+	p.Files[i], p.Files[j] = p.Files[j], p.Files[i]
+}
+
+// isGeneratedOutput reports whether the file at path was written by go-enum.  A missing file isn't.
+func isGeneratedOutput(path string) (bool, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed reading file %s: %w", path, err)
+	}
+	return bytes.HasPrefix(raw, []byte(generatedHeader)), nil
+}
+
+// staleOutputs returns the files generated for each of the source files by a run without
+// --package-file, leaving out the given outputs of the package.
+func (p *packageSources) staleOutputs(outputSuffix string, outputs map[string]bool) ([]string, error) {
+	var stale []string
+	for _, fileName := range p.Filenames {
+		outFile := outputFilePath(fileName, outputSuffix)
+		for _, path := range []string{outFile, benchmarkFilePath(outFile), testsFilePath(outFile)} {
+			if outputs[path] {
+				continue
+			}
+			generated, err := isGeneratedOutput(path)
+			if err != nil {
+				return nil, err
+			}
+			if generated {
+				stale = append(stale, path)
+			}
+		}
+	}
+	return stale, nil
+}
+
+// siblingFilenames returns the other go files of the directory that are in the package of the
+// source files, without the skipped ones.  The generated code must not declare their identifiers
+// either, whether they declare enums or not.
+func (p *packageSources) siblingFilenames(skip map[string]bool) ([]string, error) {
+	if len(p.Files) == 0 {
+		return nil, nil
+	}
+	pkg := p.Files[0].Name.Name
+	sources := make(map[string]bool, len(p.Filenames))
+	for _, fileName := range p.Filenames {
+		sources[fileName] = true
+	}
+
+	matches, err := filepath.Glob(filepath.Join(p.Dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	var siblings []string
+	for _, path := range matches {
+		if sources[path] || skip[path] {
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing file %s: %w", path, err)
+		}
+		if f.Name.Name == pkg {
+			siblings = append(siblings, path)
+		}
+	}
+	return siblings, nil
+}